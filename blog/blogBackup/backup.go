@@ -0,0 +1,504 @@
+// Package blogbackup writes and restores snapshots of a blog store.
+//
+// An archive is a gzip compressed tar file holding:
+//
+//	manifest.json       schema version, creation time, per-collection counts and checksums
+//	authors.jsonl       one JSON record per line
+//	blogs.jsonl
+//	erasure_jobs.jsonl
+//
+// Records use their own JSON schema rather than the store types so that the
+// format only changes together with SchemaVersion. New collections are added
+// to the archive as new .jsonl entries.
+package blogbackup
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"time"
+
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+)
+
+// SchemaVersion is the archive layout written by this package. Restore
+// accepts archives up to this version.
+const SchemaVersion = 1
+
+const (
+	manifestName = "manifest.json"
+	importBatch  = 500
+)
+
+// Manifest describes the content of an archive.
+type Manifest struct {
+	SchemaVersion int          `json:"schema_version"`
+	CreatedAt     time.Time    `json:"created_at"`
+	Source        string       `json:"source"`
+	Collections   []Collection `json:"collections"`
+}
+
+// Collection is one .jsonl entry of an archive.
+type Collection struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Count  int64  `json:"count"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+type blogRecord struct {
+	ID        string    `json:"id"`
+	AuthorID  string    `json:"author_id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	Tags      []string  `json:"tags,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type authorRecord struct {
+	ID          string    `json:"id"`
+	DisplayName string    `json:"display_name"`
+	Bio         string    `json:"bio,omitempty"`
+	AvatarURL   string    `json:"avatar_url,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type erasureJobRecord struct {
+	ID             string    `json:"id"`
+	AuthorID       string    `json:"author_id"`
+	Mode           string    `json:"mode"`
+	State          string    `json:"state"`
+	BlogsProcessed int64     `json:"blogs_processed"`
+	AuthorRemoved  bool      `json:"author_removed"`
+	Error          string    `json:"error,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	CompletedAt    time.Time `json:"completed_at"`
+}
+
+// collection ties an archive entry to the store: dump writes every record
+// through emit, load imports a batch of decoded lines.
+type collection struct {
+	name string
+	dump func(ctx context.Context, s blogstore.Store, emit func(interface{}) error) error
+	load func(ctx context.Context, s blogstore.Importer, lines [][]byte) error
+}
+
+// collections lists the archive entries in restore order: authors before
+// the blogs referencing them.
+var collections = []collection{
+	{
+		name: "authors",
+		dump: func(ctx context.Context, s blogstore.Store, emit func(interface{}) error) error {
+			return s.ListAuthors(ctx, func(a *blogstore.Author) error {
+				return emit(authorRecord{a.ID, a.DisplayName, a.Bio, a.AvatarURL, a.CreatedAt, a.UpdatedAt})
+			})
+		},
+		load: func(ctx context.Context, s blogstore.Importer, lines [][]byte) error {
+			authors := make([]*blogstore.Author, 0, len(lines))
+			for _, line := range lines {
+				var r authorRecord
+				if err := json.Unmarshal(line, &r); err != nil {
+					return err
+				}
+				authors = append(authors, &blogstore.Author{
+					ID: r.ID, DisplayName: r.DisplayName, Bio: r.Bio, AvatarURL: r.AvatarURL,
+					CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt,
+				})
+			}
+			return s.ImportAuthors(ctx, authors)
+		},
+	},
+	{
+		name: "blogs",
+		dump: func(ctx context.Context, s blogstore.Store, emit func(interface{}) error) error {
+			return s.ListBlogs(ctx, blogstore.Filter{}, func(b *blogstore.Blog) error {
+				return emit(blogRecord{b.ID, b.AuthorID, b.Title, b.Content, b.Tags, b.CreatedAt, b.UpdatedAt})
+			})
+		},
+		load: func(ctx context.Context, s blogstore.Importer, lines [][]byte) error {
+			blogs := make([]*blogstore.Blog, 0, len(lines))
+			for _, line := range lines {
+				var r blogRecord
+				if err := json.Unmarshal(line, &r); err != nil {
+					return err
+				}
+				blogs = append(blogs, &blogstore.Blog{
+					ID: r.ID, AuthorID: r.AuthorID, Title: r.Title, Content: r.Content, Tags: r.Tags,
+					CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt,
+				})
+			}
+			return s.ImportBlogs(ctx, blogs)
+		},
+	},
+	{
+		name: "erasure_jobs",
+		dump: func(ctx context.Context, s blogstore.Store, emit func(interface{}) error) error {
+			return s.ListErasureJobs(ctx, func(j *blogstore.ErasureJob) error {
+				return emit(erasureJobRecord{
+					j.ID, j.AuthorID, j.Mode, j.State, j.BlogsProcessed, j.AuthorRemoved, j.Error,
+					j.CreatedAt, j.UpdatedAt, j.CompletedAt,
+				})
+			})
+		},
+		load: func(ctx context.Context, s blogstore.Importer, lines [][]byte) error {
+			jobs := make([]*blogstore.ErasureJob, 0, len(lines))
+			for _, line := range lines {
+				var r erasureJobRecord
+				if err := json.Unmarshal(line, &r); err != nil {
+					return err
+				}
+				jobs = append(jobs, &blogstore.ErasureJob{
+					ID: r.ID, AuthorID: r.AuthorID, Mode: r.Mode, State: r.State,
+					BlogsProcessed: r.BlogsProcessed, AuthorRemoved: r.AuthorRemoved, Error: r.Error,
+					CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt, CompletedAt: r.CompletedAt,
+				})
+			}
+			return s.ImportErasureJobs(ctx, jobs)
+		},
+	},
+}
+
+// spool is a temporary file holding one collection with its checksum.
+type spool struct {
+	file  *os.File
+	hash  hash.Hash
+	count int64
+	size  int64
+}
+
+func newSpool() (*spool, error) {
+	f, err := os.CreateTemp("", "blogbackup-*.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	return &spool{file: f, hash: sha256.New()}, nil
+}
+
+func (s *spool) Write(p []byte) (int, error) {
+	n, err := s.file.Write(p)
+	s.hash.Write(p[:n])
+	s.size += int64(n)
+	return n, err
+}
+
+func (s *spool) sum() string {
+	return hex.EncodeToString(s.hash.Sum(nil))
+}
+
+func (s *spool) close() {
+	s.file.Close()
+	os.Remove(s.file.Name())
+}
+
+// Write dumps every collection of store into w and returns the manifest.
+// source names the backend and is recorded in the manifest.
+//
+// Collections are read one after the other; pass the Snapshot of a
+// blogstore.Snapshotter for a backup of a single point in time, otherwise
+// writes landing during the backup may or may not be included.
+func Write(ctx context.Context, store blogstore.Store, source string, w io.Writer) (*Manifest, error) {
+	m := &Manifest{SchemaVersion: SchemaVersion, CreatedAt: time.Now().UTC(), Source: source}
+	spools := make([]*spool, 0, len(collections))
+	defer func() {
+		for _, s := range spools {
+			s.close()
+		}
+	}()
+
+	for _, c := range collections {
+		sp, err := newSpool()
+		if err != nil {
+			return nil, err
+		}
+		spools = append(spools, sp)
+		buf := bufio.NewWriter(sp)
+		enc := json.NewEncoder(buf)
+		err = c.dump(ctx, store, func(record interface{}) error {
+			sp.count++
+			return enc.Encode(record)
+		})
+		if err == nil {
+			err = buf.Flush()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("dumping %s: %w", c.name, err)
+		}
+		m.Collections = append(m.Collections, Collection{
+			Name:   c.name,
+			File:   c.name + ".jsonl",
+			Count:  sp.count,
+			SHA256: sp.sum(),
+			Size:   sp.size,
+		})
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeEntry(tw, manifestName, m.CreatedAt, int64(len(manifest)), bytes.NewReader(manifest)); err != nil {
+		return nil, err
+	}
+	for i, sp := range spools {
+		if _, err := sp.file.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if err := writeEntry(tw, m.Collections[i].File, m.CreatedAt, sp.size, sp.file); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func writeEntry(tw *tar.Writer, name string, modTime time.Time, size int64, r io.Reader) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0o644,
+		Size:     size,
+		ModTime:  modTime,
+		Typeflag: tar.TypeReg,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, r)
+	return err
+}
+
+// archive is a verified archive extracted to temporary files.
+type archive struct {
+	manifest *Manifest
+	files    map[string]*os.File
+}
+
+func (a *archive) close() {
+	for _, f := range a.files {
+		f.Close()
+		os.Remove(f.Name())
+	}
+}
+
+// open reads the whole archive, checking the manifest, the checksums and
+// the record counts before anything is restored.
+func open(r io.Reader) (*archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a blog backup: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("not a blog backup: %w", err)
+	}
+	if hdr.Name != manifestName {
+		return nil, fmt.Errorf("not a blog backup: first entry is %q, want %q", hdr.Name, manifestName)
+	}
+	m := &Manifest{}
+	if err := json.NewDecoder(tr).Decode(m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	if m.SchemaVersion < 1 || m.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("unsupported schema version %d, this build restores up to %d", m.SchemaVersion, SchemaVersion)
+	}
+	expected := map[string]Collection{}
+	for _, c := range m.Collections {
+		expected[c.File] = c
+	}
+
+	a := &archive{manifest: m, files: map[string]*os.File{}}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			a.close()
+			return nil, err
+		}
+		c, ok := expected[hdr.Name]
+		if !ok {
+			a.close()
+			return nil, fmt.Errorf("unexpected archive entry %q", hdr.Name)
+		}
+		sp, err := newSpool()
+		if err != nil {
+			a.close()
+			return nil, err
+		}
+		a.files[c.Name] = sp.file
+		if _, err := io.Copy(sp, tr); err != nil {
+			a.close()
+			return nil, err
+		}
+		if sp.sum() != c.SHA256 || sp.size != c.Size {
+			a.close()
+			return nil, fmt.Errorf("checksum mismatch for %s", c.File)
+		}
+		if err := countLines(sp.file, c.Count); err != nil {
+			a.close()
+			return nil, fmt.Errorf("%s: %w", c.File, err)
+		}
+	}
+	for _, c := range m.Collections {
+		if a.files[c.Name] == nil {
+			a.close()
+			return nil, fmt.Errorf("archive is missing %s", c.File)
+		}
+	}
+	return a, nil
+}
+
+func countLines(f *os.File, want int64) error {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	var n int64
+	sc := newScanner(f)
+	for sc.Scan() {
+		n++
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if n != want {
+		return fmt.Errorf("holds %d records, manifest says %d", n, want)
+	}
+	return nil
+}
+
+func newScanner(r io.Reader) *bufio.Scanner {
+	sc := bufio.NewScanner(r)
+	// a record is a whole blog, allow large content
+	sc.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	return sc
+}
+
+// Verify checks an archive without restoring it.
+func Verify(r io.Reader) (*Manifest, error) {
+	a, err := open(r)
+	if err != nil {
+		return nil, err
+	}
+	a.close()
+	return a.manifest, nil
+}
+
+// ErrStoreNotEmpty is returned by Restore when the target already holds data.
+var ErrStoreNotEmpty = errors.New("target store is not empty")
+
+var errStop = errors.New("stop")
+
+// Restore loads the archive read from r into store, which must be empty.
+// The archive is fully verified before the first record is written. A
+// blogstore.Stager is only changed once every record is imported.
+func Restore(ctx context.Context, store blogstore.Store, r io.Reader) (*Manifest, error) {
+	if err := checkEmpty(ctx, store); err != nil {
+		return nil, err
+	}
+	a, err := open(r)
+	if err != nil {
+		return nil, err
+	}
+	defer a.close()
+
+	stager, ok := store.(blogstore.Stager)
+	if !ok {
+		importer, ok := store.(blogstore.Importer)
+		if !ok {
+			return nil, errors.New("store does not support restoring")
+		}
+		return a.manifest, load(ctx, importer, a)
+	}
+	staging, err := stager.Stage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("staging restore: %w", err)
+	}
+	if err := load(ctx, staging, a); err != nil {
+		staging.Abort(ctx)
+		return nil, err
+	}
+	if err := staging.Commit(ctx); err != nil {
+		staging.Abort(ctx)
+		if errors.Is(err, blogstore.ErrNotEmpty) {
+			return nil, ErrStoreNotEmpty
+		}
+		return nil, err
+	}
+	return a.manifest, nil
+}
+
+// load imports every collection of a into importer.
+func load(ctx context.Context, importer blogstore.Importer, a *archive) error {
+	for _, c := range collections {
+		f := a.files[c.name]
+		if f == nil {
+			// written before this collection existed
+			continue
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		sc := newScanner(f)
+		var batch [][]byte
+		for sc.Scan() {
+			batch = append(batch, append([]byte(nil), sc.Bytes()...))
+			if len(batch) == importBatch {
+				if err := c.load(ctx, importer, batch); err != nil {
+					return fmt.Errorf("restoring %s: %w", c.name, err)
+				}
+				batch = batch[:0]
+			}
+		}
+		if err := sc.Err(); err != nil {
+			return err
+		}
+		if len(batch) > 0 {
+			if err := c.load(ctx, importer, batch); err != nil {
+				return fmt.Errorf("restoring %s: %w", c.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func checkEmpty(ctx context.Context, store blogstore.Store) error {
+	found := func(interface{}) error { return errStop }
+	checks := []error{
+		store.ListBlogs(ctx, blogstore.Filter{}, func(b *blogstore.Blog) error { return found(b) }),
+		store.ListAuthors(ctx, func(a *blogstore.Author) error { return found(a) }),
+		store.ListErasureJobs(ctx, func(j *blogstore.ErasureJob) error { return found(j) }),
+	}
+	for _, err := range checks {
+		if errors.Is(err, errStop) {
+			return ErrStoreNotEmpty
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
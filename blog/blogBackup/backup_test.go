@@ -0,0 +1,211 @@
+package blogbackup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+)
+
+func seed(t *testing.T) *blogstore.MemoryStore {
+	t.Helper()
+	ctx := context.Background()
+	store := blogstore.NewMemoryStore()
+	if _, err := store.CreateAuthor(ctx, &blogstore.Author{ID: "ada", DisplayName: "Ada", Bio: "Engines"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range []*blogstore.Blog{
+		{AuthorID: "ada", Title: "Notes", Content: "On the analytical engine", Tags: []string{"history"}},
+		{AuthorID: "ada", Title: "Bernoulli numbers", Content: strings.Repeat("n", 100000)},
+	} {
+		if _, err := store.CreateBlog(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := store.CreateErasureJob(ctx, &blogstore.ErasureJob{AuthorID: "bob", Mode: "delete", State: blogstore.JobSucceeded, AuthorRemoved: true}); err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func backup(t *testing.T, store blogstore.Store) ([]byte, *Manifest) {
+	t.Helper()
+	var buf bytes.Buffer
+	m, err := Write(context.Background(), store, "memory", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), m
+}
+
+func TestRoundTrip(t *testing.T) {
+	archive, m := backup(t, seed(t))
+	counts := map[string]int64{}
+	for _, c := range m.Collections {
+		counts[c.Name] = c.Count
+	}
+	if counts["authors"] != 1 || counts["blogs"] != 2 || counts["erasure_jobs"] != 1 {
+		t.Fatalf("manifest counts = %v", counts)
+	}
+
+	restored := blogstore.NewMemoryStore()
+	if _, err := Restore(context.Background(), restored, bytes.NewReader(archive)); err != nil {
+		t.Fatal(err)
+	}
+	// the same records, ids and timestamps give the same checksums
+	_, again := backup(t, restored)
+	for i, c := range again.Collections {
+		if want := m.Collections[i]; c.SHA256 != want.SHA256 || c.Count != want.Count {
+			t.Errorf("%s after restore = %+v, want %+v", c.Name, c, want)
+		}
+	}
+
+	if _, err := Restore(context.Background(), restored, bytes.NewReader(archive)); !errors.Is(err, ErrStoreNotEmpty) {
+		t.Errorf("Restore into a non-empty store = %v, want ErrStoreNotEmpty", err)
+	}
+}
+
+type entry struct {
+	name string
+	data []byte
+}
+
+func entries(t *testing.T, archive []byte) []entry {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	var res []entry
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return res
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, entry{hdr.Name, data})
+	}
+}
+
+func pack(t *testing.T, es []entry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range es {
+		if err := tw.WriteHeader(&tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.data))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(e.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestCorruptArchive(t *testing.T) {
+	archive, _ := backup(t, seed(t))
+	// rewrite applies change to a copy of the archive entries
+	rewrite := func(change func([]entry) []entry) []byte {
+		es := entries(t, archive)
+		return pack(t, change(es))
+	}
+	manifest := func(change func(*Manifest)) []byte {
+		return rewrite(func(es []entry) []entry {
+			m := &Manifest{}
+			if err := json.Unmarshal(es[0].data, m); err != nil {
+				t.Fatal(err)
+			}
+			change(m)
+			data, err := json.Marshal(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			es[0].data = data
+			return es
+		})
+	}
+
+	tests := []struct {
+		name    string
+		archive []byte
+		want    string
+	}{
+		{"not gzip", []byte("authors,blogs"), "not a blog backup"},
+		{"truncated", archive[:len(archive)/2], "unexpected EOF"},
+		{
+			"manifest not first",
+			rewrite(func(es []entry) []entry { return append(es[1:], es[0]) }),
+			"first entry is",
+		},
+		{
+			"future schema",
+			manifest(func(m *Manifest) { m.SchemaVersion = SchemaVersion + 1 }),
+			"unsupported schema version",
+		},
+		{
+			"tampered record",
+			rewrite(func(es []entry) []entry {
+				for i := range es {
+					if es[i].name == "blogs.jsonl" {
+						es[i].data = bytes.Replace(es[i].data, []byte("Notes"), []byte("Nodes"), 1)
+					}
+				}
+				return es
+			}),
+			"checksum mismatch for blogs.jsonl",
+		},
+		{
+			"count off",
+			manifest(func(m *Manifest) { m.Collections[0].Count++ }),
+			"manifest says",
+		},
+		{
+			"missing entry",
+			rewrite(func(es []entry) []entry { return es[:len(es)-1] }),
+			"archive is missing",
+		},
+		{
+			"unexpected entry",
+			rewrite(func(es []entry) []entry { return append(es, entry{"secrets.txt", []byte("x")}) }),
+			`unexpected archive entry "secrets.txt"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Verify(bytes.NewReader(tt.archive)); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Verify = %v, want an error containing %q", err, tt.want)
+			}
+			store := blogstore.NewMemoryStore()
+			if _, err := Restore(context.Background(), store, bytes.NewReader(tt.archive)); err == nil {
+				t.Fatal("Restore succeeded")
+			}
+			_, m := backup(t, store)
+			for _, c := range m.Collections {
+				if c.Count != 0 {
+					t.Errorf("failed restore left %d %s", c.Count, c.Name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+// Package blogfeed renders RSS 2.0 and Atom feeds for the blog store.
+//
+// Routes served by Handler:
+//
+//	/feeds/{rss|atom}                  all blogs
+//	/feeds/authors/{author_id}/{rss|atom}  blogs of one author
+//	/feeds/tags/{tag}/{rss|atom}       blogs carrying a tag
+package blogfeed
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+)
+
+// DefaultLimit is the number of entries in a feed when Handler.Limit is zero.
+const DefaultLimit = 50
+
+// Handler serves the feeds over HTTP.
+type Handler struct {
+	Store blogstore.Store
+	// BaseURL is prepended to entry links, e.g. https://blog.example.com.
+	BaseURL string
+	Title   string
+	Limit   int
+	// Log receives the failures, the default logger when nil.
+	Log *logging.Logger
+}
+
+type feed struct {
+	title   string
+	self    string
+	entries []*blogstore.Blog
+	updated time.Time
+	etag    string
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	filter, format, title, ok := parsePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	f, err := h.load(r, filter, format)
+	if err != nil {
+		h.log().Error("feed: cannot list blogs", "error", err)
+		http.Error(w, "cannot load feed", http.StatusInternalServerError)
+		return
+	}
+	f.title = h.title() + title
+
+	w.Header().Set("ETag", f.etag)
+	w.Header().Set("Last-Modified", f.updated.Format(http.TimeFormat))
+	w.Header().Set("Cache-Control", "no-cache")
+	if notModified(r, f) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	var body interface{}
+	switch format {
+	case "rss":
+		w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+		body = h.rss(f)
+	default:
+		w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+		body = h.atom(f)
+	}
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		return
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(body); err != nil {
+		h.log().Warn("feed: cannot encode feed", "error", err)
+	}
+}
+
+func (h *Handler) log() *logging.Logger {
+	if h.Log != nil {
+		return h.Log
+	}
+	return logging.Default()
+}
+
+func (h *Handler) title() string {
+	if h.Title != "" {
+		return h.Title
+	}
+	return "Blogs"
+}
+
+// parsePath splits the request path into a store filter, the output
+// format and a title suffix describing the filter.
+func parsePath(path string) (blogstore.Filter, string, string, bool) {
+	var filter blogstore.Filter
+	if !strings.HasPrefix(path, "/feeds/") {
+		return filter, "", "", false
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/feeds/"), "/")
+	var format, title string
+	switch {
+	case len(parts) == 1:
+		format = parts[0]
+	case len(parts) == 3 && parts[0] == "authors" && parts[1] != "":
+		filter.AuthorID = parts[1]
+		title = " by " + parts[1]
+		format = parts[2]
+	case len(parts) == 3 && parts[0] == "tags" && parts[1] != "":
+		filter.Tag = parts[1]
+		title = " tagged " + parts[1]
+		format = parts[2]
+	default:
+		return filter, "", "", false
+	}
+	if format != "rss" && format != "atom" {
+		return filter, "", "", false
+	}
+	return filter, format, title, true
+}
+
+// load fetches the newest entries for the feed and derives its validators.
+func (h *Handler) load(r *http.Request, filter blogstore.Filter, format string) (*feed, error) {
+	filter.Recent = true
+	filter.Limit = h.Limit
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultLimit
+	}
+	var entries []*blogstore.Blog
+	err := h.Store.ListBlogs(r.Context(), filter, func(b *blogstore.Blog) error {
+		entries = append(entries, b)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// a deletion changes the feed without leaving an UpdatedAt behind
+	deleted, err := h.Store.LastBlogDeletion(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	f := &feed{self: h.BaseURL + r.URL.Path, entries: entries}
+	sum := sha256.New()
+	fmt.Fprintf(sum, "%s\n%s\n", format, h.title())
+	for _, b := range entries {
+		if b.UpdatedAt.After(f.updated) {
+			f.updated = b.UpdatedAt
+		}
+		fmt.Fprintf(sum, "%s %d\n", b.ID, b.UpdatedAt.UnixNano())
+	}
+	if deleted.After(f.updated) {
+		f.updated = deleted
+	}
+	if f.updated.IsZero() {
+		// nothing was ever published nor deleted
+		f.updated = time.Now().UTC()
+	}
+	f.updated = f.updated.Truncate(time.Second)
+	f.etag = `"` + hex.EncodeToString(sum.Sum(nil)[:16]) + `"`
+	return f, nil
+}
+
+// notModified implements the conditional GET rules of RFC 7232: If-None-Match
+// wins over If-Modified-Since when both are present.
+func notModified(r *http.Request, f *feed) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == f.etag {
+				return true
+			}
+		}
+		return false
+	}
+	ims := r.Header.Get("If-Modified-Since")
+	if ims == "" {
+		return false
+	}
+	t, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	return !f.updated.After(t)
+}
+
+func (h *Handler) entryLink(b *blogstore.Blog) string {
+	return h.BaseURL + "/blogs/" + url.PathEscape(b.ID)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Self          atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+// rssItem names the author in dc:creator: the RSS author element requires
+// an email address.
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func (h *Handler) rss(f *feed) *rssFeed {
+	out := &rssFeed{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		DC:      "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:         f.title,
+			Link:          h.BaseURL + "/",
+			Description:   f.title,
+			LastBuildDate: f.updated.Format(time.RFC1123Z),
+			Self:          atomLink{Href: f.self, Rel: "self", Type: "application/rss+xml"},
+		},
+	}
+	for _, b := range f.entries {
+		out.Channel.Items = append(out.Channel.Items, rssItem{
+			Title:       b.Title,
+			Link:        h.entryLink(b),
+			GUID:        rssGUID{Value: "urn:blog:" + b.ID},
+			Creator:     b.AuthorID,
+			Categories:  b.Tags,
+			PubDate:     b.CreatedAt.Format(time.RFC1123Z),
+			Description: b.Content,
+		})
+	}
+	return out
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     atomAuthor     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func (h *Handler) atom(f *feed) *atomFeed {
+	out := &atomFeed{
+		Title:   f.title,
+		ID:      f.self,
+		Updated: f.updated.Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.self, Rel: "self", Type: "application/atom+xml"},
+			{Href: h.BaseURL + "/", Rel: "alternate"},
+		},
+	}
+	for _, b := range f.entries {
+		e := atomEntry{
+			Title:     b.Title,
+			ID:        "urn:blog:" + b.ID,
+			Link:      atomLink{Href: h.entryLink(b), Rel: "alternate"},
+			Published: b.CreatedAt.Format(time.RFC3339),
+			Updated:   b.UpdatedAt.Format(time.RFC3339),
+			Author:    atomAuthor{Name: b.AuthorID},
+			Content:   atomContent{Type: "text", Value: b.Content},
+		}
+		for _, t := range b.Tags {
+			e.Categories = append(e.Categories, atomCategory{Term: t})
+		}
+		out.Entries = append(out.Entries, e)
+	}
+	return out
+}
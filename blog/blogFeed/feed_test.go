@@ -0,0 +1,219 @@
+package blogfeed
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+)
+
+var t0 = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+// seed imports blogs with fixed ids and times: the first one was edited
+// last.
+func seed(t *testing.T) *blogstore.MemoryStore {
+	t.Helper()
+	store := blogstore.NewMemoryStore()
+	err := store.ImportBlogs(context.Background(), []*blogstore.Blog{
+		{ID: "000000000000000000000001", AuthorID: "ada", Title: "First", CreatedAt: t0, UpdatedAt: t0.Add(3 * time.Hour)},
+		{ID: "000000000000000000000002", AuthorID: "ada", Title: "Second", Tags: []string{"go"}, CreatedAt: t0.Add(time.Hour), UpdatedAt: t0.Add(time.Hour)},
+		{ID: "000000000000000000000003", AuthorID: "alan", Title: "Third", Tags: []string{"go"}, CreatedAt: t0.Add(2 * time.Hour), UpdatedAt: t0.Add(2 * time.Hour)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+// listing records the filters the feeds list blogs with.
+type listing struct {
+	blogstore.Store
+	filters []blogstore.Filter
+}
+
+func (l *listing) ListBlogs(ctx context.Context, filter blogstore.Filter, fn func(*blogstore.Blog) error) error {
+	l.filters = append(l.filters, filter)
+	return l.Store.ListBlogs(ctx, filter, fn)
+}
+
+func get(h http.Handler, target string, header ...string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+// titles returns the titles of the entries of an RSS or Atom feed.
+func titles(t *testing.T, body []byte) []string {
+	t.Helper()
+	var feed struct {
+		Items []struct {
+			Title string `xml:"title"`
+		} `xml:"channel>item"`
+		Entries []struct {
+			Title string `xml:"title"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		t.Fatal(err)
+	}
+	var res []string
+	for _, i := range feed.Items {
+		res = append(res, i.Title)
+	}
+	for _, e := range feed.Entries {
+		res = append(res, e.Title)
+	}
+	return res
+}
+
+func TestEntries(t *testing.T) {
+	store := &listing{Store: seed(t)}
+	h := &Handler{Store: store, Limit: 2}
+	tests := []struct {
+		target      string
+		contentType string
+		want        []string
+	}{
+		{"/feeds/atom", "application/atom+xml", []string{"First", "Third"}},
+		{"/feeds/rss", "application/rss+xml", []string{"First", "Third"}},
+		{"/feeds/authors/ada/rss", "application/rss+xml", []string{"First", "Second"}},
+		{"/feeds/authors/alan/atom", "application/atom+xml", []string{"Third"}},
+		{"/feeds/tags/go/atom", "application/atom+xml", []string{"Third", "Second"}},
+	}
+	for _, tt := range tests {
+		store.filters = nil
+		w := get(h, tt.target)
+		if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), tt.contentType) {
+			t.Fatalf("%s: %d %s", tt.target, w.Code, w.Header().Get("Content-Type"))
+		}
+		if got := titles(t, w.Body.Bytes()); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%s: entries %q, want %q", tt.target, got, tt.want)
+		}
+		// the newest entries are picked by the store, not after listing
+		// every blog
+		if len(store.filters) != 1 || !store.filters[0].Recent || store.filters[0].Limit != 2 {
+			t.Errorf("%s: listed with %+v", tt.target, store.filters)
+		}
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	h := &Handler{Store: seed(t)}
+	tests := []struct {
+		method, target string
+		code           int
+	}{
+		{http.MethodGet, "/feeds/atom", http.StatusOK},
+		{http.MethodHead, "/feeds/rss", http.StatusOK},
+		{http.MethodPost, "/feeds/rss", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/feeds/json", http.StatusNotFound},
+		{http.MethodGet, "/feeds/authors//rss", http.StatusNotFound},
+		{http.MethodGet, "/feeds/authors/ada", http.StatusNotFound},
+		{http.MethodGet, "/feeds/series/go/rss", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, nil))
+		if w.Code != tt.code {
+			t.Errorf("%s %s: %d, want %d", tt.method, tt.target, w.Code, tt.code)
+		}
+	}
+}
+
+func TestConditionalGet(t *testing.T) {
+	store := seed(t)
+	h := &Handler{Store: store}
+	w := get(h, "/feeds/atom")
+	etag, modified := w.Header().Get("ETag"), w.Header().Get("Last-Modified")
+	if etag == "" || modified != t0.Add(3*time.Hour).Format(http.TimeFormat) {
+		t.Fatalf("ETag %q, Last-Modified %q", etag, modified)
+	}
+	if other := get(h, "/feeds/rss").Header().Get("ETag"); other == etag {
+		t.Errorf("RSS and Atom share the ETag %s", etag)
+	}
+
+	tests := []struct {
+		name   string
+		header []string
+		code   int
+	}{
+		{"unconditional", nil, http.StatusOK},
+		{"same etag", []string{"If-None-Match", etag}, http.StatusNotModified},
+		{"weak etag among others", []string{"If-None-Match", `"x", W/` + etag}, http.StatusNotModified},
+		{"any etag", []string{"If-None-Match", "*"}, http.StatusNotModified},
+		{"other etag", []string{"If-None-Match", `"x"`}, http.StatusOK},
+		{"not modified since", []string{"If-Modified-Since", modified}, http.StatusNotModified},
+		{"modified since", []string{"If-Modified-Since", t0.Format(http.TimeFormat)}, http.StatusOK},
+		{"bad date", []string{"If-Modified-Since", "yesterday"}, http.StatusOK},
+		{"etag wins over the date", []string{"If-None-Match", `"x"`, "If-Modified-Since", modified}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		w := get(h, "/feeds/atom", tt.header...)
+		if w.Code != tt.code {
+			t.Errorf("%s: %d, want %d", tt.name, w.Code, tt.code)
+		}
+		if tt.code == http.StatusNotModified && w.Body.Len() != 0 {
+			t.Errorf("%s: 304 with a body", tt.name)
+		}
+	}
+
+	// a deletion changes both validators
+	before := time.Now().Truncate(time.Second)
+	if err := store.DeleteBlog(context.Background(), "000000000000000000000003"); err != nil {
+		t.Fatal(err)
+	}
+	w = get(h, "/feeds/atom", "If-None-Match", etag, "If-Modified-Since", modified)
+	if w.Code != http.StatusOK || w.Header().Get("ETag") == etag {
+		t.Errorf("after a deletion: %d, ETag %s", w.Code, w.Header().Get("ETag"))
+	}
+	if lm, err := http.ParseTime(w.Header().Get("Last-Modified")); err != nil || lm.Before(before) {
+		t.Errorf("after a deletion: Last-Modified %s, want the deletion time", w.Header().Get("Last-Modified"))
+	}
+}
+
+func TestRSSCreator(t *testing.T) {
+	body := get(&Handler{Store: seed(t)}, "/feeds/authors/alan/rss").Body.String()
+	if !strings.Contains(body, "<dc:creator>alan</dc:creator>") || strings.Contains(body, "<author>") {
+		t.Errorf("RSS item author:\n%s", body)
+	}
+}
+
+func TestEmptyFeed(t *testing.T) {
+	before := time.Now().Truncate(time.Second)
+	h := &Handler{Store: blogstore.NewMemoryStore()}
+	for _, format := range []string{"rss", "atom"} {
+		w := get(h, "/feeds/"+format)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: %d", format, w.Code)
+		}
+		if lm, err := http.ParseTime(w.Header().Get("Last-Modified")); err != nil || lm.Before(before) {
+			t.Errorf("%s: Last-Modified %q, want the generation time", format, w.Header().Get("Last-Modified"))
+		}
+		var feed struct {
+			LastBuildDate string `xml:"channel>lastBuildDate"`
+			Updated       string `xml:"updated"`
+		}
+		if err := xml.Unmarshal(w.Body.Bytes(), &feed); err != nil {
+			t.Fatal(err)
+		}
+		updated, err := time.Parse(time.RFC3339, feed.Updated)
+		if format == "rss" {
+			updated, err = time.Parse(time.RFC1123Z, feed.LastBuildDate)
+		}
+		if err != nil || updated.Before(before) {
+			t.Errorf("%s: updated %q%q, want the generation time", format, feed.Updated, feed.LastBuildDate)
+		}
+		if got := titles(t, w.Body.Bytes()); len(got) != 0 {
+			t.Errorf("%s: entries %q", format, got)
+		}
+	}
+}
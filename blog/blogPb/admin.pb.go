@@ -0,0 +1,1058 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.15.5
+// source: blog/blogpb/admin.proto
+
+//    https://developers.google.com/protocol-buffers/docs/style
+
+package blogpb
+
+import (
+	context "context"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErasureMode int32
+
+const (
+	ErasureMode_ERASURE_MODE_UNSPECIFIED ErasureMode = 0 // rejected
+	ErasureMode_ERASURE_MODE_DELETE      ErasureMode = 1 // delete the author's blogs
+	ErasureMode_ERASURE_MODE_ANONYMISE   ErasureMode = 2 // keep the blogs, detach them from the author
+)
+
+// Enum value maps for ErasureMode.
+var (
+	ErasureMode_name = map[int32]string{
+		0: "ERASURE_MODE_UNSPECIFIED",
+		1: "ERASURE_MODE_DELETE",
+		2: "ERASURE_MODE_ANONYMISE",
+	}
+	ErasureMode_value = map[string]int32{
+		"ERASURE_MODE_UNSPECIFIED": 0,
+		"ERASURE_MODE_DELETE":      1,
+		"ERASURE_MODE_ANONYMISE":   2,
+	}
+)
+
+func (x ErasureMode) Enum() *ErasureMode {
+	p := new(ErasureMode)
+	*p = x
+	return p
+}
+
+func (x ErasureMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErasureMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_blogpb_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (ErasureMode) Type() protoreflect.EnumType {
+	return &file_blog_blogpb_admin_proto_enumTypes[0]
+}
+
+func (x ErasureMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErasureMode.Descriptor instead.
+func (ErasureMode) EnumDescriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type ErasureState int32
+
+const (
+	ErasureState_ERASURE_STATE_UNSPECIFIED ErasureState = 0
+	ErasureState_ERASURE_STATE_PENDING     ErasureState = 1
+	ErasureState_ERASURE_STATE_RUNNING     ErasureState = 2
+	ErasureState_ERASURE_STATE_SUCCEEDED   ErasureState = 3
+	ErasureState_ERASURE_STATE_FAILED      ErasureState = 4
+)
+
+// Enum value maps for ErasureState.
+var (
+	ErasureState_name = map[int32]string{
+		0: "ERASURE_STATE_UNSPECIFIED",
+		1: "ERASURE_STATE_PENDING",
+		2: "ERASURE_STATE_RUNNING",
+		3: "ERASURE_STATE_SUCCEEDED",
+		4: "ERASURE_STATE_FAILED",
+	}
+	ErasureState_value = map[string]int32{
+		"ERASURE_STATE_UNSPECIFIED": 0,
+		"ERASURE_STATE_PENDING":     1,
+		"ERASURE_STATE_RUNNING":     2,
+		"ERASURE_STATE_SUCCEEDED":   3,
+		"ERASURE_STATE_FAILED":      4,
+	}
+)
+
+func (x ErasureState) Enum() *ErasureState {
+	p := new(ErasureState)
+	*p = x
+	return p
+}
+
+func (x ErasureState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErasureState) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_blogpb_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (ErasureState) Type() protoreflect.EnumType {
+	return &file_blog_blogpb_admin_proto_enumTypes[1]
+}
+
+func (x ErasureState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErasureState.Descriptor instead.
+func (ErasureState) EnumDescriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type ErasureJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId       string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Mode           ErasureMode            `protobuf:"varint,3,opt,name=mode,proto3,enum=blog.ErasureMode" json:"mode,omitempty"`
+	State          ErasureState           `protobuf:"varint,4,opt,name=state,proto3,enum=blog.ErasureState" json:"state,omitempty"`
+	BlogsProcessed int64                  `protobuf:"varint,5,opt,name=blogs_processed,json=blogsProcessed,proto3" json:"blogs_processed,omitempty"`
+	AuthorRemoved  bool                   `protobuf:"varint,6,opt,name=author_removed,json=authorRemoved,proto3" json:"author_removed,omitempty"`
+	Error          string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // set when state is FAILED
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *ErasureJob) Reset() {
+	*x = ErasureJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blog_blogpb_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErasureJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureJob) ProtoMessage() {}
+
+func (x *ErasureJob) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blogpb_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureJob.ProtoReflect.Descriptor instead.
+func (*ErasureJob) Descriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ErasureJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ErasureJob) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ErasureJob) GetMode() ErasureMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ErasureMode_ERASURE_MODE_UNSPECIFIED
+}
+
+func (x *ErasureJob) GetState() ErasureState {
+	if x != nil {
+		return x.State
+	}
+	return ErasureState_ERASURE_STATE_UNSPECIFIED
+}
+
+func (x *ErasureJob) GetBlogsProcessed() int64 {
+	if x != nil {
+		return x.BlogsProcessed
+	}
+	return 0
+}
+
+func (x *ErasureJob) GetAuthorRemoved() bool {
+	if x != nil {
+		return x.AuthorRemoved
+	}
+	return false
+}
+
+func (x *ErasureJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ErasureJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ErasureJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ErasureJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// The anonymous author, owning the anonymised blogs, cannot be erased nor
+// exported.
+type EraseAuthorDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorId string      `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Mode     ErasureMode `protobuf:"varint,2,opt,name=mode,proto3,enum=blog.ErasureMode" json:"mode,omitempty"`
+}
+
+func (x *EraseAuthorDataRequest) Reset() {
+	*x = EraseAuthorDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blog_blogpb_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseAuthorDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseAuthorDataRequest) ProtoMessage() {}
+
+func (x *EraseAuthorDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blogpb_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseAuthorDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseAuthorDataRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EraseAuthorDataRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *EraseAuthorDataRequest) GetMode() ErasureMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ErasureMode_ERASURE_MODE_UNSPECIFIED
+}
+
+type EraseAuthorDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *ErasureJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *EraseAuthorDataResponse) Reset() {
+	*x = EraseAuthorDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blog_blogpb_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseAuthorDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseAuthorDataResponse) ProtoMessage() {}
+
+func (x *EraseAuthorDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blogpb_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseAuthorDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseAuthorDataResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EraseAuthorDataResponse) GetJob() *ErasureJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetErasureJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetErasureJobRequest) Reset() {
+	*x = GetErasureJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blog_blogpb_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetErasureJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErasureJobRequest) ProtoMessage() {}
+
+func (x *GetErasureJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blogpb_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErasureJobRequest.ProtoReflect.Descriptor instead.
+func (*GetErasureJobRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetErasureJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetErasureJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *ErasureJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *GetErasureJobResponse) Reset() {
+	*x = GetErasureJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blog_blogpb_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetErasureJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErasureJobResponse) ProtoMessage() {}
+
+func (x *GetErasureJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blogpb_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErasureJobResponse.ProtoReflect.Descriptor instead.
+func (*GetErasureJobResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetErasureJobResponse) GetJob() *ErasureJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ExportAuthorDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorId string `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+}
+
+func (x *ExportAuthorDataRequest) Reset() {
+	*x = ExportAuthorDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blog_blogpb_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAuthorDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuthorDataRequest) ProtoMessage() {}
+
+func (x *ExportAuthorDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blogpb_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuthorDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuthorDataRequest) Descriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportAuthorDataRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type ExportAuthorDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Item:
+	//	*ExportAuthorDataResponse_Author
+	//	*ExportAuthorDataResponse_Blog
+	//	*ExportAuthorDataResponse_ErasureJob
+	Item isExportAuthorDataResponse_Item `protobuf_oneof:"item"`
+}
+
+func (x *ExportAuthorDataResponse) Reset() {
+	*x = ExportAuthorDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blog_blogpb_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAuthorDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuthorDataResponse) ProtoMessage() {}
+
+func (x *ExportAuthorDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_blogpb_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuthorDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuthorDataResponse) Descriptor() ([]byte, []int) {
+	return file_blog_blogpb_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *ExportAuthorDataResponse) GetItem() isExportAuthorDataResponse_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (x *ExportAuthorDataResponse) GetAuthor() *Author {
+	if x, ok := x.GetItem().(*ExportAuthorDataResponse_Author); ok {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *ExportAuthorDataResponse) GetBlog() *Blog {
+	if x, ok := x.GetItem().(*ExportAuthorDataResponse_Blog); ok {
+		return x.Blog
+	}
+	return nil
+}
+
+func (x *ExportAuthorDataResponse) GetErasureJob() *ErasureJob {
+	if x, ok := x.GetItem().(*ExportAuthorDataResponse_ErasureJob); ok {
+		return x.ErasureJob
+	}
+	return nil
+}
+
+type isExportAuthorDataResponse_Item interface {
+	isExportAuthorDataResponse_Item()
+}
+
+type ExportAuthorDataResponse_Author struct {
+	Author *Author `protobuf:"bytes,1,opt,name=author,proto3,oneof"`
+}
+
+type ExportAuthorDataResponse_Blog struct {
+	Blog *Blog `protobuf:"bytes,2,opt,name=blog,proto3,oneof"`
+}
+
+type ExportAuthorDataResponse_ErasureJob struct {
+	ErasureJob *ErasureJob `protobuf:"bytes,3,opt,name=erasure_job,json=erasureJob,proto3,oneof"`
+}
+
+func (*ExportAuthorDataResponse_Author) isExportAuthorDataResponse_Item() {}
+
+func (*ExportAuthorDataResponse_Blog) isExportAuthorDataResponse_Item() {}
+
+func (*ExportAuthorDataResponse_ErasureJob) isExportAuthorDataResponse_Item() {}
+
+var File_blog_blogpb_admin_proto protoreflect.FileDescriptor
+
+var file_blog_blogpb_admin_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x62, 0x6c, 0x6f, 0x67, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x18, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x62, 0x6c, 0x6f, 0x67,
+	0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x03, 0x0a, 0x0a,
+	0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x45, 0x72, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x67,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x67, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x7e, 0x0a, 0x16, 0x45, 0x72, 0x61, 0x73, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0xfa, 0x42, 0x11, 0x72, 0x0f, 0x10, 0x01, 0x18, 0x40, 0x5a, 0x09, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x31, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x3d, 0x0a, 0x17, 0x45, 0x72, 0x61, 0x73, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6c,
+	0x6f, 0x67, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a,
+	0x6f, 0x62, 0x22, 0x36, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a,
+	0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x4c, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xfa, 0x42, 0x11, 0x72, 0x0f, 0x10, 0x01, 0x18, 0x40,
+	0x5a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x52, 0x08, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x04, 0x62, 0x6c,
+	0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e,
+	0x42, 0x6c, 0x6f, 0x67, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x67, 0x12, 0x33, 0x0a, 0x0b,
+	0x65, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x4a, 0x6f, 0x62, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f,
+	0x62, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x2a, 0x60, 0x0a, 0x0b, 0x45, 0x72, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x41, 0x53,
+	0x55, 0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x41, 0x53, 0x55, 0x52,
+	0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x1a, 0x0a, 0x16, 0x45, 0x52, 0x41, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x41, 0x4e, 0x4f, 0x4e, 0x59, 0x4d, 0x49, 0x53, 0x45, 0x10, 0x02, 0x2a, 0x9a, 0x01, 0x0a, 0x0c,
+	0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19,
+	0x45, 0x52, 0x41, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x45,
+	0x52, 0x41, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x52, 0x41, 0x53, 0x55, 0x52,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x41, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18,
+	0x0a, 0x14, 0x45, 0x52, 0x41, 0x53, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0x81, 0x02, 0x0a, 0x10, 0x42, 0x6c, 0x6f,
+	0x67, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a,
+	0x0f, 0x45, 0x72, 0x61, 0x73, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x1a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6c, 0x6f,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x72, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x62, 0x6c,
+	0x6f, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6c, 0x6f,
+	0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x0d, 0x5a, 0x0b,
+	0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_blog_blogpb_admin_proto_rawDescOnce sync.Once
+	file_blog_blogpb_admin_proto_rawDescData = file_blog_blogpb_admin_proto_rawDesc
+)
+
+func file_blog_blogpb_admin_proto_rawDescGZIP() []byte {
+	file_blog_blogpb_admin_proto_rawDescOnce.Do(func() {
+		file_blog_blogpb_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_blog_blogpb_admin_proto_rawDescData)
+	})
+	return file_blog_blogpb_admin_proto_rawDescData
+}
+
+var file_blog_blogpb_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_blog_blogpb_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_blog_blogpb_admin_proto_goTypes = []interface{}{
+	(ErasureMode)(0),                 // 0: blog.ErasureMode
+	(ErasureState)(0),                // 1: blog.ErasureState
+	(*ErasureJob)(nil),               // 2: blog.ErasureJob
+	(*EraseAuthorDataRequest)(nil),   // 3: blog.EraseAuthorDataRequest
+	(*EraseAuthorDataResponse)(nil),  // 4: blog.EraseAuthorDataResponse
+	(*GetErasureJobRequest)(nil),     // 5: blog.GetErasureJobRequest
+	(*GetErasureJobResponse)(nil),    // 6: blog.GetErasureJobResponse
+	(*ExportAuthorDataRequest)(nil),  // 7: blog.ExportAuthorDataRequest
+	(*ExportAuthorDataResponse)(nil), // 8: blog.ExportAuthorDataResponse
+	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
+	(*Author)(nil),                   // 10: blog.Author
+	(*Blog)(nil),                     // 11: blog.Blog
+}
+var file_blog_blogpb_admin_proto_depIdxs = []int32{
+	0,  // 0: blog.ErasureJob.mode:type_name -> blog.ErasureMode
+	1,  // 1: blog.ErasureJob.state:type_name -> blog.ErasureState
+	9,  // 2: blog.ErasureJob.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: blog.ErasureJob.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: blog.ErasureJob.completed_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: blog.EraseAuthorDataRequest.mode:type_name -> blog.ErasureMode
+	2,  // 6: blog.EraseAuthorDataResponse.job:type_name -> blog.ErasureJob
+	2,  // 7: blog.GetErasureJobResponse.job:type_name -> blog.ErasureJob
+	10, // 8: blog.ExportAuthorDataResponse.author:type_name -> blog.Author
+	11, // 9: blog.ExportAuthorDataResponse.blog:type_name -> blog.Blog
+	2,  // 10: blog.ExportAuthorDataResponse.erasure_job:type_name -> blog.ErasureJob
+	3,  // 11: blog.BlogAdminService.EraseAuthorData:input_type -> blog.EraseAuthorDataRequest
+	5,  // 12: blog.BlogAdminService.GetErasureJob:input_type -> blog.GetErasureJobRequest
+	7,  // 13: blog.BlogAdminService.ExportAuthorData:input_type -> blog.ExportAuthorDataRequest
+	4,  // 14: blog.BlogAdminService.EraseAuthorData:output_type -> blog.EraseAuthorDataResponse
+	6,  // 15: blog.BlogAdminService.GetErasureJob:output_type -> blog.GetErasureJobResponse
+	8,  // 16: blog.BlogAdminService.ExportAuthorData:output_type -> blog.ExportAuthorDataResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_blog_blogpb_admin_proto_init() }
+func file_blog_blogpb_admin_proto_init() {
+	if File_blog_blogpb_admin_proto != nil {
+		return
+	}
+	file_blog_blogpb_author_proto_init()
+	file_blog_blogpb_blog_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_blog_blogpb_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErasureJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blog_blogpb_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseAuthorDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blog_blogpb_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseAuthorDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blog_blogpb_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetErasureJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blog_blogpb_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetErasureJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blog_blogpb_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAuthorDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blog_blogpb_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAuthorDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_blog_blogpb_admin_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ExportAuthorDataResponse_Author)(nil),
+		(*ExportAuthorDataResponse_Blog)(nil),
+		(*ExportAuthorDataResponse_ErasureJob)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_blog_blogpb_admin_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_blog_blogpb_admin_proto_goTypes,
+		DependencyIndexes: file_blog_blogpb_admin_proto_depIdxs,
+		EnumInfos:         file_blog_blogpb_admin_proto_enumTypes,
+		MessageInfos:      file_blog_blogpb_admin_proto_msgTypes,
+	}.Build()
+	File_blog_blogpb_admin_proto = out.File
+	file_blog_blogpb_admin_proto_rawDesc = nil
+	file_blog_blogpb_admin_proto_goTypes = nil
+	file_blog_blogpb_admin_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BlogAdminServiceClient is the client API for BlogAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlogAdminServiceClient interface {
+	// EraseAuthorData starts a background job and returns immediately; poll
+	// GetErasureJob for its progress. Jobs survive server restarts.
+	EraseAuthorData(ctx context.Context, in *EraseAuthorDataRequest, opts ...grpc.CallOption) (*EraseAuthorDataResponse, error)
+	GetErasureJob(ctx context.Context, in *GetErasureJobRequest, opts ...grpc.CallOption) (*GetErasureJobResponse, error)
+	// ExportAuthorData streams everything held about an author.
+	ExportAuthorData(ctx context.Context, in *ExportAuthorDataRequest, opts ...grpc.CallOption) (BlogAdminService_ExportAuthorDataClient, error)
+}
+
+type blogAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlogAdminServiceClient(cc grpc.ClientConnInterface) BlogAdminServiceClient {
+	return &blogAdminServiceClient{cc}
+}
+
+func (c *blogAdminServiceClient) EraseAuthorData(ctx context.Context, in *EraseAuthorDataRequest, opts ...grpc.CallOption) (*EraseAuthorDataResponse, error) {
+	out := new(EraseAuthorDataResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogAdminService/EraseAuthorData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogAdminServiceClient) GetErasureJob(ctx context.Context, in *GetErasureJobRequest, opts ...grpc.CallOption) (*GetErasureJobResponse, error) {
+	out := new(GetErasureJobResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogAdminService/GetErasureJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogAdminServiceClient) ExportAuthorData(ctx context.Context, in *ExportAuthorDataRequest, opts ...grpc.CallOption) (BlogAdminService_ExportAuthorDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogAdminService_serviceDesc.Streams[0], "/blog.BlogAdminService/ExportAuthorData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogAdminServiceExportAuthorDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogAdminService_ExportAuthorDataClient interface {
+	Recv() (*ExportAuthorDataResponse, error)
+	grpc.ClientStream
+}
+
+type blogAdminServiceExportAuthorDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogAdminServiceExportAuthorDataClient) Recv() (*ExportAuthorDataResponse, error) {
+	m := new(ExportAuthorDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BlogAdminServiceServer is the server API for BlogAdminService service.
+type BlogAdminServiceServer interface {
+	// EraseAuthorData starts a background job and returns immediately; poll
+	// GetErasureJob for its progress. Jobs survive server restarts.
+	EraseAuthorData(context.Context, *EraseAuthorDataRequest) (*EraseAuthorDataResponse, error)
+	GetErasureJob(context.Context, *GetErasureJobRequest) (*GetErasureJobResponse, error)
+	// ExportAuthorData streams everything held about an author.
+	ExportAuthorData(*ExportAuthorDataRequest, BlogAdminService_ExportAuthorDataServer) error
+}
+
+// UnimplementedBlogAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBlogAdminServiceServer struct {
+}
+
+func (*UnimplementedBlogAdminServiceServer) EraseAuthorData(context.Context, *EraseAuthorDataRequest) (*EraseAuthorDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseAuthorData not implemented")
+}
+func (*UnimplementedBlogAdminServiceServer) GetErasureJob(context.Context, *GetErasureJobRequest) (*GetErasureJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetErasureJob not implemented")
+}
+func (*UnimplementedBlogAdminServiceServer) ExportAuthorData(*ExportAuthorDataRequest, BlogAdminService_ExportAuthorDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAuthorData not implemented")
+}
+
+func RegisterBlogAdminServiceServer(s *grpc.Server, srv BlogAdminServiceServer) {
+	s.RegisterService(&_BlogAdminService_serviceDesc, srv)
+}
+
+func _BlogAdminService_EraseAuthorData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseAuthorDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogAdminServiceServer).EraseAuthorData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogAdminService/EraseAuthorData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogAdminServiceServer).EraseAuthorData(ctx, req.(*EraseAuthorDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogAdminService_GetErasureJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetErasureJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogAdminServiceServer).GetErasureJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogAdminService/GetErasureJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogAdminServiceServer).GetErasureJob(ctx, req.(*GetErasureJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogAdminService_ExportAuthorData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAuthorDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogAdminServiceServer).ExportAuthorData(m, &blogAdminServiceExportAuthorDataServer{stream})
+}
+
+type BlogAdminService_ExportAuthorDataServer interface {
+	Send(*ExportAuthorDataResponse) error
+	grpc.ServerStream
+}
+
+type blogAdminServiceExportAuthorDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogAdminServiceExportAuthorDataServer) Send(m *ExportAuthorDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BlogAdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.BlogAdminService",
+	HandlerType: (*BlogAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EraseAuthorData",
+			Handler:    _BlogAdminService_EraseAuthorData_Handler,
+		},
+		{
+			MethodName: "GetErasureJob",
+			Handler:    _BlogAdminService_GetErasureJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAuthorData",
+			Handler:       _BlogAdminService_ExportAuthorData_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "blog/blogpb/admin.proto",
+}
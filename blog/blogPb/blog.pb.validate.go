@@ -0,0 +1,1956 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: blog/blogpb/blog.proto
+
+package blogpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Blog with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Blog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Blog with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in BlogMultiError, or nil if none found.
+func (m *Blog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Blog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_Blog_Id_Pattern.MatchString(m.GetId()) {
+		err := BlogValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^([0-9a-f]{24})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetAuthorId()); l < 1 || l > 64 {
+		err := BlogValidationError{
+			field:  "AuthorId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 200 {
+		err := BlogValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) > 100000 {
+		err := BlogValidationError{
+			field:  "Content",
+			reason: "value length must be at most 100000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 20 {
+		err := BlogValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_Blog_Tags_Unique := make(map[string]struct{}, len(m.GetTags()))
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+		if _, exists := _Blog_Tags_Unique[item]; exists {
+			err := BlogValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_Blog_Tags_Unique[item] = struct{}{}
+		}
+		if l := utf8.RuneCountInString(item); l < 1 || l > 50 {
+			err := BlogValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlogMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlogMultiError is an error wrapping multiple validation errors returned by
+// Blog.ValidateAll() if the designated constraints aren't met.
+type BlogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlogMultiError) AllErrors() []error { return m }
+
+// BlogValidationError is the validation error returned by Blog.Validate if the
+// designated constraints aren't met.
+type BlogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlogValidationError) ErrorName() string { return "BlogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlogValidationError{}
+
+var _Blog_Id_Pattern = regexp.MustCompile("^([0-9a-f]{24})?$")
+
+// Validate checks the field values on CreateBlogRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateBlogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CreateBlogRequestMultiError, or nil if none found.
+func (m *CreateBlogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBlogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBlog() == nil {
+		err := CreateBlogRequestValidationError{
+			field:  "Blog",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBlogRequestValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBlogRequestValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBlogRequestValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBlogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBlogRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateBlogRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBlogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBlogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBlogRequestMultiError) AllErrors() []error { return m }
+
+// CreateBlogRequestValidationError is the validation error returned by
+// CreateBlogRequest.Validate if the designated constraints aren't met.
+type CreateBlogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBlogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBlogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBlogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBlogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBlogRequestValidationError) ErrorName() string {
+	return "CreateBlogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBlogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBlogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBlogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBlogRequestValidationError{}
+
+// Validate checks the field values on CreateBlogResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateBlogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBlogResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CreateBlogResponseMultiError, or nil if none found.
+func (m *CreateBlogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBlogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBlogResponseValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBlogResponseValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBlogResponseValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBlogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBlogResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateBlogResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBlogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBlogResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBlogResponseMultiError) AllErrors() []error { return m }
+
+// CreateBlogResponseValidationError is the validation error returned by
+// CreateBlogResponse.Validate if the designated constraints aren't met.
+type CreateBlogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBlogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBlogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBlogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBlogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBlogResponseValidationError) ErrorName() string {
+	return "CreateBlogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBlogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBlogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBlogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBlogResponseValidationError{}
+
+// Validate checks the field values on ReadBlogRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReadBlogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadBlogRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReadBlogRequestMultiError, or
+// nil if none found.
+func (m *ReadBlogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadBlogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ReadBlogRequest_BlogId_Pattern.MatchString(m.GetBlogId()) {
+		err := ReadBlogRequestValidationError{
+			field:  "BlogId",
+			reason: "value does not match regex pattern \"^[0-9a-f]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeAuthor
+
+	if len(errors) > 0 {
+		return ReadBlogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadBlogRequestMultiError is an error wrapping multiple validation errors
+// returned by ReadBlogRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReadBlogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadBlogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadBlogRequestMultiError) AllErrors() []error { return m }
+
+// ReadBlogRequestValidationError is the validation error returned by
+// ReadBlogRequest.Validate if the designated constraints aren't met.
+type ReadBlogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadBlogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadBlogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadBlogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadBlogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadBlogRequestValidationError) ErrorName() string { return "ReadBlogRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadBlogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadBlogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadBlogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadBlogRequestValidationError{}
+
+var _ReadBlogRequest_BlogId_Pattern = regexp.MustCompile("^[0-9a-f]{24}$")
+
+// Validate checks the field values on ReadBlogResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadBlogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadBlogResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ReadBlogResponseMultiError, or nil if none found.
+func (m *ReadBlogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadBlogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadBlogResponseValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadBlogResponseValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadBlogResponseValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadBlogResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadBlogResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadBlogResponseValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadBlogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadBlogResponseMultiError is an error wrapping multiple validation errors
+// returned by ReadBlogResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReadBlogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadBlogResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadBlogResponseMultiError) AllErrors() []error { return m }
+
+// ReadBlogResponseValidationError is the validation error returned by
+// ReadBlogResponse.Validate if the designated constraints aren't met.
+type ReadBlogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadBlogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadBlogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadBlogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadBlogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadBlogResponseValidationError) ErrorName() string { return "ReadBlogResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadBlogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadBlogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadBlogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadBlogResponseValidationError{}
+
+// Validate checks the field values on UpdateBlogRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBlogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UpdateBlogRequestMultiError, or nil if none found.
+func (m *UpdateBlogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBlogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBlog() == nil {
+		err := UpdateBlogRequestValidationError{
+			field:  "Blog",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBlogRequestValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBlogRequestValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBlogRequestValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBlogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBlogRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateBlogRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBlogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBlogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBlogRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBlogRequestValidationError is the validation error returned by
+// UpdateBlogRequest.Validate if the designated constraints aren't met.
+type UpdateBlogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBlogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBlogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBlogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBlogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBlogRequestValidationError) ErrorName() string {
+	return "UpdateBlogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBlogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBlogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBlogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBlogRequestValidationError{}
+
+// Validate checks the field values on UpdateBlogResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBlogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBlogResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// UpdateBlogResponseMultiError, or nil if none found.
+func (m *UpdateBlogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBlogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBlogResponseValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBlogResponseValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBlogResponseValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBlogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBlogResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateBlogResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBlogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBlogResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBlogResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBlogResponseValidationError is the validation error returned by
+// UpdateBlogResponse.Validate if the designated constraints aren't met.
+type UpdateBlogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBlogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBlogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBlogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBlogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBlogResponseValidationError) ErrorName() string {
+	return "UpdateBlogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBlogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBlogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBlogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBlogResponseValidationError{}
+
+// Validate checks the field values on DeleteBlogRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBlogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteBlogRequestMultiError, or nil if none found.
+func (m *DeleteBlogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBlogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DeleteBlogRequest_BlogId_Pattern.MatchString(m.GetBlogId()) {
+		err := DeleteBlogRequestValidationError{
+			field:  "BlogId",
+			reason: "value does not match regex pattern \"^[0-9a-f]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteBlogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBlogRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteBlogRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBlogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBlogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBlogRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBlogRequestValidationError is the validation error returned by
+// DeleteBlogRequest.Validate if the designated constraints aren't met.
+type DeleteBlogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBlogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBlogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBlogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBlogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBlogRequestValidationError) ErrorName() string {
+	return "DeleteBlogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBlogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBlogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBlogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBlogRequestValidationError{}
+
+var _DeleteBlogRequest_BlogId_Pattern = regexp.MustCompile("^[0-9a-f]{24}$")
+
+// Validate checks the field values on DeleteBlogResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBlogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBlogResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DeleteBlogResponseMultiError, or nil if none found.
+func (m *DeleteBlogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBlogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	if len(errors) > 0 {
+		return DeleteBlogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBlogResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteBlogResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBlogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBlogResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBlogResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBlogResponseValidationError is the validation error returned by
+// DeleteBlogResponse.Validate if the designated constraints aren't met.
+type DeleteBlogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBlogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBlogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBlogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBlogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBlogResponseValidationError) ErrorName() string {
+	return "DeleteBlogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBlogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBlogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBlogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBlogResponseValidationError{}
+
+// Validate checks the field values on ListBlogRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListBlogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlogRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListBlogRequestMultiError, or
+// nil if none found.
+func (m *ListBlogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAuthorId()) > 64 {
+		err := ListBlogRequestValidationError{
+			field:  "AuthorId",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTag()) > 50 {
+		err := ListBlogRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListBlogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlogRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBlogRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBlogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlogRequestMultiError) AllErrors() []error { return m }
+
+// ListBlogRequestValidationError is the validation error returned by
+// ListBlogRequest.Validate if the designated constraints aren't met.
+type ListBlogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlogRequestValidationError) ErrorName() string { return "ListBlogRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListBlogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlogRequestValidationError{}
+
+// Validate checks the field values on ListBlogResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBlogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlogResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListBlogResponseMultiError, or nil if none found.
+func (m *ListBlogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListBlogResponseValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListBlogResponseValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListBlogResponseValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListBlogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlogResponseMultiError is an error wrapping multiple validation errors
+// returned by ListBlogResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListBlogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlogResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlogResponseMultiError) AllErrors() []error { return m }
+
+// ListBlogResponseValidationError is the validation error returned by
+// ListBlogResponse.Validate if the designated constraints aren't met.
+type ListBlogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlogResponseValidationError) ErrorName() string { return "ListBlogResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListBlogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlogResponseValidationError{}
+
+// Validate checks the field values on GetRelatedBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelatedBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelatedBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetRelatedBlogsRequestMultiError, or nil if none found.
+func (m *GetRelatedBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelatedBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetRelatedBlogsRequest_BlogId_Pattern.MatchString(m.GetBlogId()) {
+		err := GetRelatedBlogsRequestValidationError{
+			field:  "BlogId",
+			reason: "value does not match regex pattern \"^[0-9a-f]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := GetRelatedBlogsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRelatedBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRelatedBlogsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRelatedBlogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRelatedBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelatedBlogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelatedBlogsRequestMultiError) AllErrors() []error { return m }
+
+// GetRelatedBlogsRequestValidationError is the validation error returned by
+// GetRelatedBlogsRequest.Validate if the designated constraints aren't met.
+type GetRelatedBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelatedBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelatedBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelatedBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelatedBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelatedBlogsRequestValidationError) ErrorName() string {
+	return "GetRelatedBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelatedBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelatedBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelatedBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelatedBlogsRequestValidationError{}
+
+var _GetRelatedBlogsRequest_BlogId_Pattern = regexp.MustCompile("^[0-9a-f]{24}$")
+
+// Validate checks the field values on RelatedBlog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *RelatedBlog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelatedBlog with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in RelatedBlogMultiError, or nil if
+// none found.
+func (m *RelatedBlog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelatedBlog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelatedBlogValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelatedBlogValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelatedBlogValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return RelatedBlogMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelatedBlogMultiError is an error wrapping multiple validation errors
+// returned by RelatedBlog.ValidateAll() if the designated constraints aren't
+// met.
+type RelatedBlogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelatedBlogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelatedBlogMultiError) AllErrors() []error { return m }
+
+// RelatedBlogValidationError is the validation error returned by
+// RelatedBlog.Validate if the designated constraints aren't met.
+type RelatedBlogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelatedBlogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelatedBlogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelatedBlogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelatedBlogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelatedBlogValidationError) ErrorName() string { return "RelatedBlogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RelatedBlogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelatedBlog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelatedBlogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelatedBlogValidationError{}
+
+// Validate checks the field values on GetRelatedBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelatedBlogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelatedBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GetRelatedBlogsResponseMultiError, or nil if none found.
+func (m *GetRelatedBlogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelatedBlogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBlogs() {
+		_, _ = idx, item
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRelatedBlogsResponseValidationError{
+						field:  fmt.Sprintf("Blogs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRelatedBlogsResponseValidationError{
+						field:  fmt.Sprintf("Blogs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRelatedBlogsResponseValidationError{
+					field:  fmt.Sprintf("Blogs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRelatedBlogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRelatedBlogsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRelatedBlogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRelatedBlogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelatedBlogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelatedBlogsResponseMultiError) AllErrors() []error { return m }
+
+// GetRelatedBlogsResponseValidationError is the validation error returned by
+// GetRelatedBlogsResponse.Validate if the designated constraints aren't met.
+type GetRelatedBlogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelatedBlogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelatedBlogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelatedBlogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelatedBlogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelatedBlogsResponseValidationError) ErrorName() string {
+	return "GetRelatedBlogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelatedBlogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelatedBlogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelatedBlogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelatedBlogsResponseValidationError{}
@@ -0,0 +1,236 @@
+// Package blogprivacy implements erasure of everything the blog service
+// holds about an author, as background jobs that survive restarts.
+package blogprivacy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+)
+
+// AnonymousAuthorID replaces the author of anonymised blogs.
+const AnonymousAuthorID = "anonymous"
+
+const (
+	batchSize = 100
+	// pollInterval is how often unfinished jobs are picked up even without
+	// a Submit, e.g. jobs left behind by another instance.
+	pollInterval = time.Minute
+)
+
+var errBatchFull = errors.New("batch full")
+
+// ErrAnonymousAuthor is returned by Submit for the AnonymousAuthorID: it
+// owns the anonymised blogs of every erased author, and anonymising them
+// again would never end.
+var ErrAnonymousAuthor = errors.New("the anonymous author holds no personal data")
+
+// ErrModeConflict is returned by Submit, with the unfinished job of the
+// author, when that job erases in another mode.
+var ErrModeConflict = errors.New("author has an unfinished erasure job in another mode")
+
+// Eraser runs erasure jobs one at a time in the background. Every step is
+// idempotent and progress is checkpointed after each batch, so a job
+// interrupted by a restart is simply picked up again by the next Eraser.
+type Eraser struct {
+	store blogstore.Store
+	wake  chan struct{}
+
+	mu      sync.Mutex
+	running bool
+	done    chan struct{}
+}
+
+// NewEraser returns an eraser working on store. Pass the store the
+// handlers use so that observers such as the related-blogs index see the
+// deletions.
+func NewEraser(store blogstore.Store) *Eraser {
+	return &Eraser{store: store, wake: make(chan struct{}, 1)}
+}
+
+// Start launches the worker, which also resumes jobs left unfinished by a
+// previous run, logging to the logger of ctx. It stops when ctx is
+// cancelled; Wait blocks until then.
+func (e *Eraser) Start(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.running {
+		return
+	}
+	e.running = true
+	e.done = make(chan struct{})
+	go e.loop(ctx)
+	e.notify()
+}
+
+// Wait blocks until the worker started by Start has returned.
+func (e *Eraser) Wait() {
+	e.mu.Lock()
+	done := e.done
+	e.mu.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+// Submit records a pending erasure job for authorID and wakes the worker.
+// If the author already has an unfinished job in mode, that job is
+// returned instead; in another mode, it is returned with ErrModeConflict.
+func (e *Eraser) Submit(ctx context.Context, authorID, mode string) (*blogstore.ErasureJob, error) {
+	if authorID == AnonymousAuthorID {
+		return nil, ErrAnonymousAuthor
+	}
+	job, err := e.store.CreateErasureJob(ctx, &blogstore.ErasureJob{
+		AuthorID: authorID,
+		Mode:     mode,
+		State:    blogstore.JobPending,
+	})
+	if errors.Is(err, blogstore.ErrJobExists) {
+		if job.Mode != mode {
+			return job, ErrModeConflict
+		}
+		return job, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.notify()
+	return job, nil
+}
+
+func (e *Eraser) notify() {
+	select {
+	case e.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (e *Eraser) loop(ctx context.Context) {
+	defer close(e.done)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.wake:
+		case <-ticker.C:
+		}
+		e.runPending(ctx)
+	}
+}
+
+func (e *Eraser) runPending(ctx context.Context) {
+	var jobs []*blogstore.ErasureJob
+	err := e.store.ListErasureJobs(ctx, func(j *blogstore.ErasureJob) error {
+		if blogstore.Unfinished(j.State) {
+			jobs = append(jobs, j)
+		}
+		return nil
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("erasure: cannot list jobs", "error", err)
+		return
+	}
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := e.run(ctx, job); err != nil {
+			if ctx.Err() != nil {
+				// shutting down, the job stays running and is resumed later
+				return
+			}
+			logging.FromContext(ctx).Error("erasure: job failed", "job", job.ID, "error", err)
+			job.State = blogstore.JobFailed
+			job.Error = err.Error()
+			job.CompletedAt = time.Now().UTC()
+			if err := e.save(ctx, job); err != nil {
+				logging.FromContext(ctx).Error("erasure: cannot record the failure", "job", job.ID, "error", err)
+			}
+		}
+	}
+}
+
+func (e *Eraser) run(ctx context.Context, job *blogstore.ErasureJob) error {
+	// the job id only, logs must not keep the author being erased
+	logging.FromContext(ctx).Info("erasure: running job", "job", job.ID, "mode", job.Mode)
+	if job.AuthorID == AnonymousAuthorID {
+		// recorded before Submit refused it
+		return ErrAnonymousAuthor
+	}
+	job.State = blogstore.JobRunning
+	if err := e.save(ctx, job); err != nil {
+		return err
+	}
+
+	// erased blogs stop matching the filter, so each pass starts over and
+	// there is no cursor to persist
+	for {
+		var batch []*blogstore.Blog
+		err := e.store.ListBlogs(ctx, blogstore.Filter{AuthorID: job.AuthorID}, func(b *blogstore.Blog) error {
+			batch = append(batch, b)
+			if len(batch) == batchSize {
+				return errBatchFull
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errBatchFull) {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, b := range batch {
+			if err := e.erase(ctx, job.Mode, b); err != nil {
+				return err
+			}
+		}
+		job.BlogsProcessed += int64(len(batch))
+		if err := e.save(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	if err := e.store.DeleteAuthor(ctx, job.AuthorID); err != nil && !errors.Is(err, blogstore.ErrAuthorNotFound) {
+		return err
+	}
+	job.AuthorRemoved = true
+	job.State = blogstore.JobSucceeded
+	job.CompletedAt = time.Now().UTC()
+	if err := e.save(ctx, job); err != nil {
+		return err
+	}
+	logging.FromContext(ctx).Info("erasure: job done", "job", job.ID, "blogs", job.BlogsProcessed)
+	return nil
+}
+
+// save checkpoints job, refreshing it with what the store recorded.
+func (e *Eraser) save(ctx context.Context, job *blogstore.ErasureJob) error {
+	updated, err := e.store.UpdateErasureJob(ctx, job)
+	if err != nil {
+		return err
+	}
+	*job = *updated
+	return nil
+}
+
+func (e *Eraser) erase(ctx context.Context, mode string, b *blogstore.Blog) error {
+	if mode == blogstore.ErasureAnonymise {
+		b.AuthorID = AnonymousAuthorID
+		_, err := e.store.UpdateBlog(ctx, b)
+		if errors.Is(err, blogstore.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	err := e.store.DeleteBlog(ctx, b.ID)
+	if errors.Is(err, blogstore.ErrNotFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,215 @@
+package blogprivacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+)
+
+// seed stores an author with more blogs than a batch, and a blog of
+// someone else.
+func seed(t *testing.T) *blogstore.MemoryStore {
+	t.Helper()
+	ctx := context.Background()
+	store := blogstore.NewMemoryStore()
+	if _, err := store.CreateAuthor(ctx, &blogstore.Author{ID: "ada", DisplayName: "Ada"}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < batchSize+20; i++ {
+		if _, err := store.CreateBlog(ctx, &blogstore.Blog{AuthorID: "ada", Title: fmt.Sprintf("Note %d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := store.CreateBlog(ctx, &blogstore.Blog{AuthorID: "alan", Title: "Computable numbers"}); err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+// start runs an eraser on store until the test ends.
+func start(t *testing.T, store blogstore.Store) *Eraser {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	e := NewEraser(store)
+	e.Start(ctx)
+	t.Cleanup(func() {
+		cancel()
+		e.Wait()
+	})
+	return e
+}
+
+// finished waits for the job to finish and returns it.
+func finished(t *testing.T, store blogstore.Store, id string) *blogstore.ErasureJob {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		job, err := store.ReadErasureJob(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !blogstore.Unfinished(job.State) {
+			return job
+		}
+	}
+	t.Fatalf("job %s still unfinished", id)
+	return nil
+}
+
+// authors counts the blogs by author.
+func authors(t *testing.T, store blogstore.Store) map[string]int {
+	t.Helper()
+	counts := map[string]int{}
+	err := store.ListBlogs(context.Background(), blogstore.Filter{}, func(b *blogstore.Blog) error {
+		counts[b.AuthorID]++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return counts
+}
+
+func TestErasure(t *testing.T) {
+	tests := []struct {
+		mode string
+		want map[string]int
+	}{
+		{blogstore.ErasureDelete, map[string]int{"alan": 1}},
+		{blogstore.ErasureAnonymise, map[string]int{"alan": 1, AnonymousAuthorID: batchSize + 20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			store := seed(t)
+			e := start(t, store)
+			job, err := e.Submit(context.Background(), "ada", tt.mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			job = finished(t, store, job.ID)
+			if job.State != blogstore.JobSucceeded || job.BlogsProcessed != batchSize+20 || !job.AuthorRemoved || job.CompletedAt.IsZero() {
+				t.Errorf("job = %+v", job)
+			}
+			if got := authors(t, store); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("blogs by author = %v, want %v", got, tt.want)
+			}
+			if _, err := store.ReadAuthor(context.Background(), "ada"); !errors.Is(err, blogstore.ErrAuthorNotFound) {
+				t.Errorf("ReadAuthor = %v, want ErrAuthorNotFound", err)
+			}
+		})
+	}
+}
+
+func TestResumeAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	store := seed(t)
+	// a previous instance stopped in the middle of the job: one batch done
+	// and checkpointed, the author still there
+	job, err := store.CreateErasureJob(ctx, &blogstore.ErasureJob{AuthorID: "ada", Mode: blogstore.ErasureDelete, State: blogstore.JobRunning})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var batch []string
+	err = store.ListBlogs(ctx, blogstore.Filter{AuthorID: "ada", Limit: batchSize}, func(b *blogstore.Blog) error {
+		batch = append(batch, b.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range batch {
+		if err := store.DeleteBlog(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	job.BlogsProcessed = batchSize
+	if _, err := store.UpdateErasureJob(ctx, job); err != nil {
+		t.Fatal(err)
+	}
+
+	start(t, store)
+	job = finished(t, store, job.ID)
+	if job.State != blogstore.JobSucceeded || job.BlogsProcessed != batchSize+20 || !job.AuthorRemoved {
+		t.Errorf("job = %+v", job)
+	}
+	if got := authors(t, store); got["ada"] != 0 || got["alan"] != 1 {
+		t.Errorf("blogs by author = %v", got)
+	}
+}
+
+func TestOneUnfinishedJobPerAuthor(t *testing.T) {
+	ctx := context.Background()
+	store := seed(t)
+	// not started, so the first job stays pending
+	e := NewEraser(store)
+	first, err := e.Submit(ctx, "ada", blogstore.ErasureDelete)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		author  string
+		mode    string
+		same    bool // the first job is returned
+		wantErr error
+	}{
+		{name: "same mode", author: "ada", mode: blogstore.ErasureDelete, same: true},
+		{name: "other mode", author: "ada", mode: blogstore.ErasureAnonymise, same: true, wantErr: ErrModeConflict},
+		{name: "other author", author: "alan", mode: blogstore.ErasureAnonymise},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := e.Submit(ctx, tt.author, tt.mode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Submit = %v, want %v", err, tt.wantErr)
+			}
+			if (job.ID == first.ID) != tt.same {
+				t.Errorf("job %s returned, first job %s", job.ID, first.ID)
+			}
+		})
+	}
+
+	// once finished, the author can be erased again
+	start(t, store)
+	finished(t, store, first.ID)
+	again, err := e.Submit(ctx, "ada", blogstore.ErasureAnonymise)
+	if err != nil || again.ID == first.ID {
+		t.Errorf("Submit after the job finished = %+v, %v, want a new job", again, err)
+	}
+}
+
+func TestAnonymousAuthor(t *testing.T) {
+	ctx := context.Background()
+	store := seed(t)
+	e := start(t, store)
+	first, err := e.Submit(ctx, "ada", blogstore.ErasureAnonymise)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finished(t, store, first.ID)
+	for _, mode := range []string{blogstore.ErasureDelete, blogstore.ErasureAnonymise} {
+		if _, err := e.Submit(ctx, AnonymousAuthorID, mode); !errors.Is(err, ErrAnonymousAuthor) {
+			t.Errorf("Submit(%s) = %v, want %v", mode, err, ErrAnonymousAuthor)
+		}
+	}
+
+	// a job recorded before Submit refused it fails instead of running
+	// forever
+	job, err := store.CreateErasureJob(ctx, &blogstore.ErasureJob{AuthorID: AnonymousAuthorID, Mode: blogstore.ErasureAnonymise, State: blogstore.JobPending})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.notify()
+	job = finished(t, store, job.ID)
+	if job.State != blogstore.JobFailed || job.BlogsProcessed != 0 {
+		t.Errorf("job = %+v", job)
+	}
+	if got := authors(t, store); got[AnonymousAuthorID] != batchSize+20 {
+		t.Errorf("blogs by author = %v", got)
+	}
+}
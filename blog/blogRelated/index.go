@@ -0,0 +1,284 @@
+// Package blogrelated keeps a "you might also like" index over the blogs.
+//
+// Two blogs are scored by the overlap of their tags (Jaccard) and by the
+// cosine similarity of the TF-IDF vectors of their title and content. The
+// pairwise scores are maintained incrementally: when a blog changes only its
+// own row is recomputed, against the blogs sharing at least one term or tag
+// with it, so reading related blogs is a lookup rather than a scan.
+//
+// IDF weights drift as the corpus grows; vectors are re-weighted whenever
+// their blog changes, and Rebuild re-weights everything.
+package blogrelated
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+)
+
+const (
+	tagWeight  = 0.4
+	textWeight = 0.6
+	// titleBoost counts every title term as this many content terms.
+	titleBoost = 2
+	// minScore drops pairs that are too weak to be worth recommending.
+	minScore = 0.01
+)
+
+type doc struct {
+	tags   map[string]bool
+	tf     map[string]float64
+	vector map[string]float64
+	norm   float64
+}
+
+// Scored is a related blog id with its similarity to the queried blog.
+type Scored struct {
+	ID    string
+	Score float64
+}
+
+// Index is safe for concurrent use. It implements blogstore.Observer.
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[string]*doc
+	df       map[string]int
+	terms    map[string]map[string]bool // term -> blog ids
+	tags     map[string]map[string]bool // tag -> blog ids
+	scores   map[string]map[string]float64
+	stopword map[string]bool
+}
+
+// New returns an empty index.
+func New() *Index {
+	idx := &Index{stopword: map[string]bool{}}
+	for _, w := range strings.Fields(stopwords) {
+		idx.stopword[w] = true
+	}
+	idx.reset()
+	return idx
+}
+
+func (idx *Index) reset() {
+	idx.docs = map[string]*doc{}
+	idx.df = map[string]int{}
+	idx.terms = map[string]map[string]bool{}
+	idx.tags = map[string]map[string]bool{}
+	idx.scores = map[string]map[string]float64{}
+}
+
+// Rebuild replaces the index content with every blog in store.
+func (idx *Index) Rebuild(ctx context.Context, store blogstore.Store) error {
+	var blogs []*blogstore.Blog
+	err := store.ListBlogs(ctx, blogstore.Filter{}, func(b *blogstore.Blog) error {
+		blogs = append(blogs, b)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.reset()
+	for _, b := range blogs {
+		idx.addTerms(b.ID, idx.newDoc(b))
+	}
+	for id := range idx.docs {
+		idx.weigh(idx.docs[id])
+	}
+	for id := range idx.docs {
+		idx.rescore(id)
+	}
+	return nil
+}
+
+// BlogChanged indexes a created or updated blog.
+func (idx *Index) BlogChanged(b *blogstore.Blog) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(b.ID)
+	d := idx.newDoc(b)
+	idx.addTerms(b.ID, d)
+	idx.weigh(d)
+	idx.rescore(b.ID)
+}
+
+// BlogDeleted drops a blog and every score involving it.
+func (idx *Index) BlogDeleted(id string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(id)
+}
+
+// Related returns up to limit blogs most similar to id, best first.
+func (idx *Index) Related(id string, limit int) []Scored {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	res := make([]Scored, 0, len(idx.scores[id]))
+	for other, score := range idx.scores[id] {
+		res = append(res, Scored{ID: other, Score: score})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].ID < res[j].ID
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
+
+func (idx *Index) newDoc(b *blogstore.Blog) *doc {
+	d := &doc{tags: map[string]bool{}, tf: map[string]float64{}}
+	for _, t := range b.Tags {
+		d.tags[strings.ToLower(t)] = true
+	}
+	for _, t := range idx.tokenize(b.Title) {
+		d.tf[t] += titleBoost
+	}
+	for _, t := range idx.tokenize(b.Content) {
+		d.tf[t]++
+	}
+	return d
+}
+
+func (idx *Index) tokenize(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	res := words[:0]
+	for _, w := range words {
+		if len([]rune(w)) > 1 && !idx.stopword[w] {
+			res = append(res, w)
+		}
+	}
+	return res
+}
+
+func (idx *Index) addTerms(id string, d *doc) {
+	idx.docs[id] = d
+	for t := range d.tf {
+		idx.df[t]++
+		if idx.terms[t] == nil {
+			idx.terms[t] = map[string]bool{}
+		}
+		idx.terms[t][id] = true
+	}
+	for t := range d.tags {
+		if idx.tags[t] == nil {
+			idx.tags[t] = map[string]bool{}
+		}
+		idx.tags[t][id] = true
+	}
+}
+
+func (idx *Index) remove(id string) {
+	d, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	for t := range d.tf {
+		idx.df[t]--
+		if idx.df[t] == 0 {
+			delete(idx.df, t)
+		}
+		delete(idx.terms[t], id)
+		if len(idx.terms[t]) == 0 {
+			delete(idx.terms, t)
+		}
+	}
+	for t := range d.tags {
+		delete(idx.tags[t], id)
+		if len(idx.tags[t]) == 0 {
+			delete(idx.tags, t)
+		}
+	}
+	for other := range idx.scores[id] {
+		delete(idx.scores[other], id)
+	}
+	delete(idx.scores, id)
+	delete(idx.docs, id)
+}
+
+// weigh computes the TF-IDF vector of d with the current document frequencies.
+func (idx *Index) weigh(d *doc) {
+	n := float64(len(idx.docs))
+	d.vector = make(map[string]float64, len(d.tf))
+	d.norm = 0
+	for t, tf := range d.tf {
+		w := (1 + math.Log(tf)) * math.Log(1+n/float64(idx.df[t]))
+		d.vector[t] = w
+		d.norm += w * w
+	}
+	d.norm = math.Sqrt(d.norm)
+}
+
+// rescore recomputes the scores between id and every blog sharing a term or tag with it.
+func (idx *Index) rescore(id string) {
+	d := idx.docs[id]
+	candidates := map[string]bool{}
+	for t := range d.tf {
+		for other := range idx.terms[t] {
+			candidates[other] = true
+		}
+	}
+	for t := range d.tags {
+		for other := range idx.tags[t] {
+			candidates[other] = true
+		}
+	}
+	delete(candidates, id)
+
+	for other := range candidates {
+		score := similarity(d, idx.docs[other])
+		if score < minScore {
+			continue
+		}
+		if idx.scores[id] == nil {
+			idx.scores[id] = map[string]float64{}
+		}
+		if idx.scores[other] == nil {
+			idx.scores[other] = map[string]float64{}
+		}
+		idx.scores[id][other] = score
+		idx.scores[other][id] = score
+	}
+}
+
+func similarity(a, b *doc) float64 {
+	var tags float64
+	if len(a.tags) > 0 || len(b.tags) > 0 {
+		shared := 0
+		for t := range a.tags {
+			if b.tags[t] {
+				shared++
+			}
+		}
+		tags = float64(shared) / float64(len(a.tags)+len(b.tags)-shared)
+	}
+
+	var text float64
+	if a.norm > 0 && b.norm > 0 {
+		small, large := a.vector, b.vector
+		if len(small) > len(large) {
+			small, large = large, small
+		}
+		for t, w := range small {
+			text += w * large[t]
+		}
+		text /= a.norm * b.norm
+	}
+	return tagWeight*tags + textWeight*text
+}
+
+const stopwords = `a an and are as at be but by for from has have i in is it its of on or
+that the this to was were will with you your we our not no so if then than there
+their they them he she his her what which who how when where why can do does`
@@ -0,0 +1,138 @@
+package blogrelated
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+)
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b blogstore.Blog
+		want float64 // 0 when b is not related to a
+	}{
+		{
+			name: "same tags",
+			a:    blogstore.Blog{Tags: []string{"go"}},
+			b:    blogstore.Blog{Tags: []string{"Go"}},
+			want: tagWeight,
+		},
+		{
+			name: "one tag of three shared",
+			a:    blogstore.Blog{Tags: []string{"go", "grpc"}},
+			b:    blogstore.Blog{Tags: []string{"go", "mongo"}},
+			want: tagWeight / 3,
+		},
+		{
+			name: "same text",
+			a:    blogstore.Blog{Title: "Streaming RPCs", Content: "deadlines and retries"},
+			b:    blogstore.Blog{Title: "Streaming RPCs", Content: "deadlines and retries"},
+			want: textWeight,
+		},
+		{
+			name: "same text and tags",
+			a:    blogstore.Blog{Title: "Streaming RPCs", Tags: []string{"grpc"}},
+			b:    blogstore.Blog{Title: "Streaming RPCs", Tags: []string{"grpc"}},
+			want: tagWeight + textWeight,
+		},
+		{
+			name: "nothing shared",
+			a:    blogstore.Blog{Title: "Streaming RPCs", Tags: []string{"grpc"}},
+			b:    blogstore.Blog{Title: "Baking bread", Tags: []string{"food"}},
+		},
+		{
+			name: "stop words and single letters only",
+			a:    blogstore.Blog{Title: "What is it", Content: "a b c"},
+			b:    blogstore.Blog{Title: "What is it", Content: "a b c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx := New()
+			a, b := tt.a, tt.b
+			a.ID, b.ID = "a", "b"
+			idx.BlogChanged(&a)
+			idx.BlogChanged(&b)
+
+			got := idx.Related("a", 10)
+			if tt.want == 0 {
+				if len(got) != 0 {
+					t.Fatalf("Related = %v, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0].ID != "b" || math.Abs(got[0].Score-tt.want) > 1e-9 {
+				t.Fatalf("Related = %v, want b scored %v", got, tt.want)
+			}
+			if back := idx.Related("b", 10); len(back) != 1 || back[0].Score != got[0].Score {
+				t.Errorf("Related(b) = %v, want the same score as Related(a)", back)
+			}
+		})
+	}
+}
+
+func TestRelatedOrderAndChanges(t *testing.T) {
+	blogs := []*blogstore.Blog{
+		{ID: "grpc", Title: "Streaming with gRPC", Tags: []string{"go", "grpc"}},
+		{ID: "grpc-deadlines", Title: "Deadlines with gRPC", Tags: []string{"go", "grpc"}},
+		{ID: "go", Title: "Generics", Tags: []string{"go"}},
+		{ID: "bread", Title: "Sourdough", Tags: []string{"food"}},
+	}
+	// the store hands out the ids, name maps them back
+	store := blogstore.NewMemoryStore()
+	id, name := map[string]string{}, map[string]string{}
+	for _, b := range blogs {
+		created, err := store.CreateBlog(context.Background(), b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id[b.ID], name[created.ID] = created.ID, b.ID
+	}
+	idx := New()
+	if err := idx.Rebuild(context.Background(), store); err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		name   string
+		change func()
+		limit  int
+		want   []string
+	}{
+		{name: "best first", limit: 10, want: []string{"grpc-deadlines", "go"}},
+		{name: "limited", limit: 1, want: []string{"grpc-deadlines"}},
+		{
+			name:   "tags dropped",
+			change: func() { idx.BlogChanged(&blogstore.Blog{ID: id["grpc-deadlines"], Title: "Deadlines"}) },
+			limit:  10,
+			want:   []string{"go"},
+		},
+		{
+			name:   "deleted",
+			change: func() { idx.BlogDeleted(id["go"]) },
+			limit:  10,
+			want:   nil,
+		},
+	}
+	for _, s := range steps {
+		if s.change != nil {
+			s.change()
+		}
+		got := idx.Related(id["grpc"], s.limit)
+		var ids []string
+		for _, r := range got {
+			ids = append(ids, name[r.ID])
+		}
+		if len(ids) != len(s.want) {
+			t.Fatalf("%s: Related = %v, want %v", s.name, ids, s.want)
+		}
+		for i := range ids {
+			if ids[i] != s.want[i] {
+				t.Fatalf("%s: Related = %v, want %v", s.name, ids, s.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"time"
+
+	blogservice "github.com/dipjyotimetia/gogrpc/blog/blogService"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+)
+
+// settings of the blog server, from the config file, BLOG_* variables and
+// flags.
+type settings struct {
+	Addr        string `config:"addr" usage:"gRPC and gRPC-Web listen address"`
+	HTTPAddr    string `config:"http-addr" usage:"HTTP listen address for the RSS/Atom feeds and the /v1 JSON API, empty to disable"`
+	MetricsAddr string `config:"metrics-addr" usage:"listen address of the Prometheus /metrics endpoint and the health probes, empty to disable"`
+	AdminAddr   string `config:"admin-addr" usage:"listen address of /debug/logging, changing the logging at runtime, empty to disable; keep it on loopback"`
+	WebOrigins  string `config:"grpc-web-origins" usage:"comma separated origins allowed to call over gRPC-Web from other sites, * for any, none when empty"`
+
+	Blog      blogservice.Settings `config:",inline"`
+	Deadlines config.Deadlines     `config:",inline"`
+	Shutdown  config.Shutdown      `config:",inline"`
+	Health    config.Health        `config:",inline"`
+	Tracing   config.Tracing       `config:",inline"`
+	Logging   config.Logging       `config:",inline"`
+	TLS       config.ServerTLS     `config:",inline"`
+}
+
+func defaultSettings() settings {
+	return settings{
+		Addr:        "0.0.0.0:50053",
+		HTTPAddr:    "0.0.0.0:8080",
+		MetricsAddr: "0.0.0.0:9093",
+		AdminAddr:   "127.0.0.1:9193",
+		Blog:        blogservice.DefaultSettings(),
+		Deadlines:   config.Deadlines{Default: 30 * time.Second},
+		Shutdown:    config.DefaultShutdown(),
+		Health:      config.DefaultHealth(),
+		Tracing:     config.DefaultTracing(),
+		Logging:     config.DefaultLogging(),
+		TLS:         config.ServerTLS{ReloadInterval: 30 * time.Second},
+	}
+}
+
+func (c *settings) Check(p *config.Problems) {
+	p.Addr("addr", c.Addr, false)
+	p.Addr("http-addr", c.HTTPAddr, true)
+	p.Addr("metrics-addr", c.MetricsAddr, true)
+	p.Addr("admin-addr", c.AdminAddr, true)
+	p.Distinct(map[string]string{"addr": c.Addr, "http-addr": c.HTTPAddr, "metrics-addr": c.MetricsAddr, "admin-addr": c.AdminAddr})
+	c.Blog.Check(p)
+	c.Deadlines.Check(p)
+	c.Shutdown.Check(p)
+	c.Health.Check(p)
+	c.Tracing.Check(p)
+	c.Logging.Check(p)
+	c.TLS.Check(p, false)
+}
@@ -2,243 +2,153 @@ package main
 
 import (
 	"context"
-	"fmt"
-	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
+	"crypto/tls"
+	blogservice "github.com/dipjyotimetia/gogrpc/blog/blogService"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+	"github.com/dipjyotimetia/gogrpc/internal/deadline"
+	"github.com/dipjyotimetia/gogrpc/internal/gateway"
+	"github.com/dipjyotimetia/gogrpc/internal/grpcweb"
+	"github.com/dipjyotimetia/gogrpc/internal/healthcheck"
+	"github.com/dipjyotimetia/gogrpc/internal/lifecycle"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/metrics"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
+	"github.com/dipjyotimetia/gogrpc/internal/validation"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/grpc/status"
-	"log"
 	"net"
-	"os"
-	"os/signal"
+	"net/http"
+	"strings"
 	"time"
 )
 
-var collection *mongo.Collection
-
-type server struct{}
-
-type blogItem struct {
-	Id       primitive.ObjectID `bson:"_id,omitempty"`
-	AuthorId string             `bson:"author_id"`
-	Content  string             `bson:"content"`
-	Title    string             `bson:"title"`
-}
-
-func (s server) CreateBlog(ctx context.Context, request *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
-	blog := request.GetBlog()
-
-	data := &blogItem{
-		Id:       primitive.ObjectID{},
-		AuthorId: blog.GetAuthorId(),
-		Content:  blog.GetContent(),
-		Title:    blog.GetTitle(),
-	}
-	res, err := collection.InsertOne(context.Background(), data)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Internal error: %v", err))
-	}
-	oid, ok := res.InsertedID.(primitive.ObjectID)
-	if !ok {
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Cannot convert to OID: %v", err))
-	}
-	return &blogpb.CreateBlogResponse{
-		Blog: &blogpb.Blog{
-			Id:       oid.Hex(),
-			AuthorId: blog.AuthorId,
-			Title:    blog.GetTitle(),
-			Content:  blog.GetContent(),
-		}}, nil
-}
-
-func (s server) ReadBlog(ctx context.Context, request *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
-	blogID := request.GetBlogId()
-	oid, err := primitive.ObjectIDFromHex(blogID)
+func main() {
+	cfg := defaultSettings()
+	effective, err := config.Load("blog", &cfg)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Cannot parse id %v", err))
-	}
-	data := &blogItem{}
-	filter := bson.M{"_id": oid}
-
-	res := collection.FindOne(context.Background(), filter)
-	if err := res.Decode(data); err != nil {
-		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Cannot find blog with specified id %v", err))
-	}
-
-	return &blogpb.ReadBlogResponse{Blog: &blogpb.Blog{
-		Id:       data.Id.Hex(),
-		AuthorId: data.AuthorId,
-		Title:    data.Title,
-		Content:  data.Content,
-	}}, nil
-}
-
-func dataToBlogPb(data *blogItem) *blogpb.Blog {
-	return &blogpb.Blog{
-		Id:       data.Id.Hex(),
-		AuthorId: data.AuthorId,
-		Content:  data.Content,
-		Title:    data.Title,
+		logging.Default().Fatal("invalid configuration", "error", err)
 	}
-}
+	logger, payloads := cfg.Logging.Logger()
+	logger.Info("configuration", effective.Fields()...)
 
-func (*server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
-	fmt.Println("Update blog request")
-	blog := req.GetBlog()
-	oid, err := primitive.ObjectIDFromHex(blog.GetId())
+	lis, err := net.Listen("tcp", cfg.Addr) //nolint:gosec
 	if err != nil {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			fmt.Sprintf("Cannot parse ID"),
-		)
+		logger.Fatal("failed to listen", "addr", cfg.Addr, "error", err)
 	}
 
-	// create an empty struct
-	data := &blogItem{}
-	filter := bson.M{"_id": oid}
-
-	res := collection.FindOne(context.Background(), filter)
-	if err := res.Decode(data); err != nil {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Cannot find blog with specified ID: %v", err),
-		)
-	}
-
-	// we update our internal struct
-	data.AuthorId = blog.GetAuthorId()
-	data.Content = blog.GetContent()
-	data.Title = blog.GetTitle()
-
-	_, updateErr := collection.ReplaceOne(context.Background(), filter, data)
-	if updateErr != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Cannot update object in MongoDB: %v", updateErr),
-		)
-	}
-
-	return &blogpb.UpdateBlogResponse{
-		Blog: dataToBlogPb(data),
-	}, nil
-}
-
-func (*server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*blogpb.DeleteBlogResponse, error) {
-	fmt.Println("Delete blog request")
-	oid, err := primitive.ObjectIDFromHex(req.GetBlogId())
+	registry := metrics.NewRegistry()
+	rpcMetrics := metrics.NewServerRPC(registry)
+	tracer, err := cfg.Tracing.Tracer("blog", logger)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			fmt.Sprintf("Cannot parse ID"),
-		)
+		logger.Fatal("failed to start tracing", "error", err)
 	}
-
-	filter := bson.M{"_id": oid}
-
-	res, err := collection.DeleteOne(context.Background(), filter)
-
+	lc := cfg.Shutdown.Lifecycle(logger)
+	lc.AddDependency("tracer", tracer.Shutdown)
+	cfg.Blog.Metrics = registry
+	cfg.Blog.Tracer = tracer
+	cfg.Blog.Logger = logger
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	blog, err := blogservice.Open(ctx, cfg.Blog)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Cannot delete object in MongoDB: %v", err),
-		)
-	}
-
-	if res.DeletedCount == 0 {
-		return nil, status.Errorf(
-			codes.NotFound,
-			fmt.Sprintf("Cannot find blog in MongoDB: %v", err),
-		)
+		logger.Fatal("failed to open the blog service", "error", err)
 	}
+	lc.AddDependency("blog service", blog.Close)
 
-	return &blogpb.DeleteBlogResponse{BlogId: req.GetBlogId()}, nil
-}
-
-func (*server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_ListBlogServer) error {
-	fmt.Println("List blog request")
-
-	cur, err := collection.Find(context.Background(), nil)
+	deadlines, err := cfg.Deadlines.Policy()
 	if err != nil {
-		return status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Unknown internal error: %v", err),
-		)
-	}
-	defer cur.Close(context.Background())
-	for cur.Next(context.Background()) {
-		data := &blogItem{}
-		err := cur.Decode(data)
+		logger.Fatal("invalid deadlines", "error", err)
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(tracer), logging.UnaryServerInterceptor(logger, payloads), rpcMetrics.UnaryServerInterceptor(), rpcerr.UnaryServerInterceptor(), mtls.UnaryServerInterceptor(cfg.Blog.RestrictAdmin()), deadline.UnaryServerInterceptor(deadlines), validation.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(tracer), logging.StreamServerInterceptor(logger, payloads), rpcMetrics.StreamServerInterceptor(), rpcerr.StreamServerInterceptor(), mtls.StreamServerInterceptor(cfg.Blog.RestrictAdmin()), deadline.StreamServerInterceptor(deadlines), validation.StreamServerInterceptor()),
+	}
+	serverOpts := opts
+	monitor := cfg.Health.Monitor(logger)
+	// the gRPC-Web mux terminates TLS, native calls and browsers share the port
+	var tlsConfig *tls.Config
+	if cfg.TLS.Enabled() {
+		certs, err := mtls.NewReloader(cfg.TLS.Cert, cfg.TLS.Key, cfg.TLS.ClientCA, logger)
 		if err != nil {
-			return status.Errorf(
-				codes.Internal,
-				fmt.Sprintf("Error while decoding data from MongoDB: %v", err),
-			)
+			logger.Fatal("failed loading certificates", "error", err)
 		}
-		stream.Send(&blogpb.ListBlogResponse{Blog: dataToBlogPb(data)})
-	}
-	if err := cur.Err(); err != nil {
-		return status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Unknown internal error: %v", err),
-		)
-	}
-	return nil
-}
-
-func main() {
-	// if we crash the go code, we get the file and line number
-	// log.SetFlags(log.LstdFlags | log.Lshortfile)
-	fmt.Println("Blog service started")
-	lis, err := net.Listen("tcp", "0.0.0.0:50051") //nolint:gosec
-	if err != nil {
-		log.Fatalf("failed to listen server")
-	}
-
-	fmt.Println("Connecting to mongodb")
-	client, err := mongo.NewClient(options.Client().SetAuth(
-		options.Credential{
-			Username: "admin",
-			Password: "admin",
-		}).ApplyURI("mongodb://localhost:27017"))
-
-	if err != nil {
-		log.Fatalf("mongo db client failed %v", err)
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	err = client.Connect(ctx)
+		monitor.AddCheck("tls certificate", healthcheck.CertificateExpiry(certs.NotAfter))
+		if cfg.TLS.ReloadInterval > 0 {
+			go certs.Watch(context.Background(), cfg.TLS.ReloadInterval)
+		}
+		tlsConfig = certs.ServerConfig()
+		serverOpts = append(serverOpts, grpc.Creds(grpcweb.TLSCredentials()))
+	}
+	s := grpc.NewServer(serverOpts...)
+	blog.Register(s)
+	monitor.AddService(blogservice.ServiceNames...)
+	monitor.AddCheck("blog store", blog.Ping, blogservice.ServiceNames...)
+	monitor.Register(s)
+	monitor.Start()
+	reflection.Register(s)
+	web, err := grpcweb.NewServer(s, lis, tlsConfig, blog.RegisterPublic, opts, grpcweb.AllowedOrigins(strings.Split(cfg.WebOrigins, ",")...))
 	if err != nil {
-		log.Fatalf("mongo db connection failed %v", err)
+		logger.Fatal("failed to start gRPC-Web", "error", err)
+	}
+	lc.SetHealth(monitor.Server())
+	lc.AddServer("server", web)
+	lc.AddDependency("health checks", monitor.Stop)
+	if cfg.MetricsAddr != "" {
+		metricsServer := metrics.NewServer(cfg.MetricsAddr, registry, map[string]http.Handler{
+			"/debug/logging": logging.ReadOnlyHandler(logger, payloads),
+			"/healthz":       monitor,
+			"/readyz":        monitor,
+		})
+		lc.AddServer("metrics server", lifecycle.HTTP(metricsServer))
+		go func() {
+			logger.Info("serving metrics", "addr", cfg.MetricsAddr)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to serve metrics", "error", err)
+			}
+		}()
+	}
+	if cfg.AdminAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/logging", logging.Handler(logger, payloads))
+		adminServer := &http.Server{Addr: cfg.AdminAddr, Handler: mux}
+		lc.AddServer("admin server", lifecycle.HTTP(adminServer))
+		go func() {
+			logger.Info("serving admin endpoints", "addr", cfg.AdminAddr)
+			if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to serve admin endpoints", "error", err)
+			}
+		}()
 	}
-
-	collection = client.Database("mydb").Collection("blog")
-
-	opts := []grpc.ServerOption{}
-	s := grpc.NewServer(opts...)
-	blogpb.RegisterBlogServiceServer(s, &server{})
-	reflection.Register(s)
 	go func() {
-		fmt.Println("Starting server")
-		if err := s.Serve(lis); err != nil {
-			log.Fatalf("Failed to serve %v", err)
+		logger.Info("serving", "addr", cfg.Addr)
+		if err := web.Serve(); err != nil {
+			logger.Fatal("failed to serve", "error", err)
 		}
 	}()
 
-	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, os.Interrupt)
-
-	<-ch
-
-	fmt.Println("Stopping the server")
-	s.Stop()
-	fmt.Println("Stopping the listener")
-	lis.Close()
-	fmt.Println("Closing mongodb connection")
-	client.Disconnect(ctx)
-	fmt.Println("End of program")
+	if cfg.HTTPAddr != "" {
+		// the admin service stays gRPC only
+		gw, stopGateway, err := gateway.InProcess(blog.RegisterPublic, opts...)
+		if err != nil {
+			logger.Fatal("failed to start gateway", "error", err)
+		}
+		lc.AddDependency("gateway", func(context.Context) error { stopGateway(); return nil })
+		mux := http.NewServeMux()
+		mux.Handle("/feeds/", blog.Feeds())
+		mux.Handle("/v1/", gw)
+		mux.Handle("/healthz", monitor)
+		mux.Handle("/readyz", monitor)
+		httpServer := &http.Server{Addr: cfg.HTTPAddr, Handler: mux, TLSConfig: tlsConfig}
+		lc.AddServer("feed server", lifecycle.HTTP(httpServer))
+		go func() {
+			logger.Info("serving feeds", "addr", cfg.HTTPAddr)
+			if err := gateway.Serve(httpServer); err != nil {
+				logger.Fatal("failed to serve feeds", "error", err)
+			}
+		}()
+	}
+
+	lc.Wait()
 }
@@ -0,0 +1,133 @@
+package blogservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	blogprivacy "github.com/dipjyotimetia/gogrpc/blog/blogPrivacy"
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type adminServer struct {
+	store  blogstore.Store
+	eraser *blogprivacy.Eraser
+}
+
+var (
+	modeToData = map[blogpb.ErasureMode]string{
+		blogpb.ErasureMode_ERASURE_MODE_DELETE:    blogstore.ErasureDelete,
+		blogpb.ErasureMode_ERASURE_MODE_ANONYMISE: blogstore.ErasureAnonymise,
+	}
+	modeToPb = map[string]blogpb.ErasureMode{
+		blogstore.ErasureDelete:    blogpb.ErasureMode_ERASURE_MODE_DELETE,
+		blogstore.ErasureAnonymise: blogpb.ErasureMode_ERASURE_MODE_ANONYMISE,
+	}
+	stateToPb = map[string]blogpb.ErasureState{
+		blogstore.JobPending:   blogpb.ErasureState_ERASURE_STATE_PENDING,
+		blogstore.JobRunning:   blogpb.ErasureState_ERASURE_STATE_RUNNING,
+		blogstore.JobSucceeded: blogpb.ErasureState_ERASURE_STATE_SUCCEEDED,
+		blogstore.JobFailed:    blogpb.ErasureState_ERASURE_STATE_FAILED,
+	}
+)
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func dataToJobPb(data *blogstore.ErasureJob) *blogpb.ErasureJob {
+	return &blogpb.ErasureJob{
+		Id:             data.ID,
+		AuthorId:       data.AuthorID,
+		Mode:           modeToPb[data.Mode],
+		State:          stateToPb[data.State],
+		BlogsProcessed: data.BlogsProcessed,
+		AuthorRemoved:  data.AuthorRemoved,
+		Error:          data.Error,
+		CreatedAt:      timestamppb.New(data.CreatedAt),
+		UpdatedAt:      timestamppb.New(data.UpdatedAt),
+		CompletedAt:    optionalTimestamp(data.CompletedAt),
+	}
+}
+
+func (s *adminServer) EraseAuthorData(ctx context.Context, req *blogpb.EraseAuthorDataRequest) (*blogpb.EraseAuthorDataResponse, error) {
+	mode, ok := modeToData[req.GetMode()]
+	if !ok {
+		msg := fmt.Sprintf("unknown erasure mode %v", req.GetMode())
+		return nil, rpcerr.InvalidArgument(msg, &errdetails.BadRequest_FieldViolation{Field: "mode", Description: msg})
+	}
+	job, err := s.eraser.Submit(ctx, req.GetAuthorId(), mode)
+	switch {
+	case errors.Is(err, blogprivacy.ErrAnonymousAuthor):
+		return nil, rpcerr.Convert(err, rpcerr.WithField("author_id"))
+	case errors.Is(err, blogprivacy.ErrModeConflict):
+		return nil, rpcerr.Convert(err, rpcerr.WithResource(resourceErasureJob, job.ID))
+	}
+	if err != nil {
+		return nil, rpcerr.Convert(err)
+	}
+	return &blogpb.EraseAuthorDataResponse{Job: dataToJobPb(job)}, nil
+}
+
+func (s *adminServer) GetErasureJob(ctx context.Context, req *blogpb.GetErasureJobRequest) (*blogpb.GetErasureJobResponse, error) {
+	job, err := s.store.ReadErasureJob(ctx, req.GetJobId())
+	if errors.Is(err, blogstore.ErrJobNotFound) {
+		return nil, rpcerr.Convert(err, rpcerr.WithResource(resourceErasureJob, req.GetJobId()))
+	}
+	if err != nil {
+		return nil, rpcerr.Convert(err)
+	}
+	return &blogpb.GetErasureJobResponse{Job: dataToJobPb(job)}, nil
+}
+
+func (s *adminServer) ExportAuthorData(req *blogpb.ExportAuthorDataRequest, stream blogpb.BlogAdminService_ExportAuthorDataServer) error {
+	authorID := req.GetAuthorId()
+	if authorID == blogprivacy.AnonymousAuthorID {
+		return rpcerr.Convert(blogprivacy.ErrAnonymousAuthor, rpcerr.WithField("author_id"))
+	}
+	ctx := stream.Context()
+	sent := 0
+	send := func(res *blogpb.ExportAuthorDataResponse) error {
+		sent++
+		return stream.Send(res)
+	}
+
+	author, err := s.store.ReadAuthor(ctx, authorID)
+	switch {
+	case errors.Is(err, blogstore.ErrAuthorNotFound):
+	case err != nil:
+		return rpcerr.Convert(err)
+	default:
+		err = send(&blogpb.ExportAuthorDataResponse{Item: &blogpb.ExportAuthorDataResponse_Author{Author: dataToAuthorPb(author)}})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.store.ListBlogs(ctx, blogstore.Filter{AuthorID: authorID}, func(data *blogstore.Blog) error {
+		return send(&blogpb.ExportAuthorDataResponse{Item: &blogpb.ExportAuthorDataResponse_Blog{Blog: dataToBlogPb(data)}})
+	})
+	if err == nil {
+		err = s.store.ListErasureJobs(ctx, func(job *blogstore.ErasureJob) error {
+			if job.AuthorID != authorID {
+				return nil
+			}
+			return send(&blogpb.ExportAuthorDataResponse{Item: &blogpb.ExportAuthorDataResponse_ErasureJob{ErasureJob: dataToJobPb(job)}})
+		})
+	}
+	if err != nil {
+		return rpcerr.Convert(err)
+	}
+	if sent == 0 {
+		return rpcerr.Convert(errNoAuthorData, rpcerr.WithResource(resourceAuthor, authorID))
+	}
+	return nil
+}
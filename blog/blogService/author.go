@@ -0,0 +1,65 @@
+package blogservice
+
+import (
+	"context"
+
+	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type authorServer struct {
+	store blogstore.AuthorStore
+}
+
+func authorPbToData(author *blogpb.Author) *blogstore.Author {
+	return &blogstore.Author{
+		ID:          author.GetId(),
+		DisplayName: author.GetDisplayName(),
+		Bio:         author.GetBio(),
+		AvatarURL:   author.GetAvatarUrl(),
+	}
+}
+
+func dataToAuthorPb(data *blogstore.Author) *blogpb.Author {
+	return &blogpb.Author{
+		Id:          data.ID,
+		DisplayName: data.DisplayName,
+		Bio:         data.Bio,
+		AvatarUrl:   data.AvatarURL,
+		CreatedAt:   timestamppb.New(data.CreatedAt),
+		UpdatedAt:   timestamppb.New(data.UpdatedAt),
+	}
+}
+
+func (s *authorServer) CreateAuthor(ctx context.Context, req *blogpb.CreateAuthorRequest) (*blogpb.CreateAuthorResponse, error) {
+	data, err := s.store.CreateAuthor(ctx, authorPbToData(req.GetAuthor()))
+	if err != nil {
+		return nil, authorError(err, req.GetAuthor().GetId())
+	}
+	return &blogpb.CreateAuthorResponse{Author: dataToAuthorPb(data)}, nil
+}
+
+func (s *authorServer) GetAuthor(ctx context.Context, req *blogpb.GetAuthorRequest) (*blogpb.GetAuthorResponse, error) {
+	data, err := s.store.ReadAuthor(ctx, req.GetAuthorId())
+	if err != nil {
+		return nil, authorError(err, req.GetAuthorId())
+	}
+	return &blogpb.GetAuthorResponse{Author: dataToAuthorPb(data)}, nil
+}
+
+func (s *authorServer) UpdateAuthor(ctx context.Context, req *blogpb.UpdateAuthorRequest) (*blogpb.UpdateAuthorResponse, error) {
+	data, err := s.store.UpdateAuthor(ctx, authorPbToData(req.GetAuthor()))
+	if err != nil {
+		return nil, authorError(err, req.GetAuthor().GetId())
+	}
+	return &blogpb.UpdateAuthorResponse{Author: dataToAuthorPb(data)}, nil
+}
+
+func (s *authorServer) ListAuthors(req *blogpb.ListAuthorsRequest, stream blogpb.AuthorService_ListAuthorsServer) error {
+	err := s.store.ListAuthors(stream.Context(), func(data *blogstore.Author) error {
+		return stream.Send(&blogpb.ListAuthorsResponse{Author: dataToAuthorPb(data)})
+	})
+	return rpcerr.Convert(err)
+}
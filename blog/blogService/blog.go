@@ -0,0 +1,149 @@
+package blogservice
+
+import (
+	"context"
+	"errors"
+
+	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	blogrelated "github.com/dipjyotimetia/gogrpc/blog/blogRelated"
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type blogServer struct {
+	store   blogstore.Store
+	related *blogrelated.Index
+}
+
+func blogPbToData(blog *blogpb.Blog) *blogstore.Blog {
+	return &blogstore.Blog{
+		ID:       blog.GetId(),
+		AuthorID: blog.GetAuthorId(),
+		Title:    blog.GetTitle(),
+		Content:  blog.GetContent(),
+		Tags:     blog.GetTags(),
+	}
+}
+
+func dataToBlogPb(data *blogstore.Blog) *blogpb.Blog {
+	return &blogpb.Blog{
+		Id:        data.ID,
+		AuthorId:  data.AuthorID,
+		Content:   data.Content,
+		Title:     data.Title,
+		Tags:      data.Tags,
+		CreatedAt: timestamppb.New(data.CreatedAt),
+		UpdatedAt: timestamppb.New(data.UpdatedAt),
+	}
+}
+
+// checkAuthor makes sure a blog points at a registered author.
+func (s *blogServer) checkAuthor(ctx context.Context, authorID string) error {
+	_, err := s.store.ReadAuthor(ctx, authorID)
+	if errors.Is(err, blogstore.ErrAuthorNotFound) {
+		return rpcerr.Convert(err,
+			rpcerr.WithCode(codes.InvalidArgument),
+			rpcerr.WithResource(resourceAuthor, authorID),
+			rpcerr.WithField("blog.author_id"),
+		)
+	}
+	return rpcerr.Convert(err)
+}
+
+func (s *blogServer) CreateBlog(ctx context.Context, request *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
+	if err := s.checkAuthor(ctx, request.GetBlog().GetAuthorId()); err != nil {
+		return nil, err
+	}
+	data, err := s.store.CreateBlog(ctx, blogPbToData(request.GetBlog()))
+	if err != nil {
+		return nil, rpcerr.Convert(err)
+	}
+	return &blogpb.CreateBlogResponse{Blog: dataToBlogPb(data)}, nil
+}
+
+func (s *blogServer) ReadBlog(ctx context.Context, request *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
+	data, err := s.store.ReadBlog(ctx, request.GetBlogId())
+	if err != nil {
+		return nil, blogError(err, request.GetBlogId(), "blog_id")
+	}
+
+	res := &blogpb.ReadBlogResponse{Blog: dataToBlogPb(data)}
+	if request.GetIncludeAuthor() {
+		author, err := s.store.ReadAuthor(ctx, data.AuthorID)
+		switch {
+		case errors.Is(err, blogstore.ErrAuthorNotFound):
+			// blogs written before authors were registered have no profile
+		case err != nil:
+			return nil, rpcerr.Convert(err)
+		default:
+			res.Author = dataToAuthorPb(author)
+		}
+	}
+	return res, nil
+}
+
+func (s *blogServer) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
+	// the pattern of Blog.id lets CreateBlog leave it empty
+	if req.GetBlog().GetId() == "" {
+		msg := "invalid request: blog.id is required"
+		return nil, rpcerr.InvalidArgument(msg, &errdetails.BadRequest_FieldViolation{Field: "blog.id", Description: "value is required"})
+	}
+	if err := s.checkAuthor(ctx, req.GetBlog().GetAuthorId()); err != nil {
+		return nil, err
+	}
+	data, err := s.store.UpdateBlog(ctx, blogPbToData(req.GetBlog()))
+	if err != nil {
+		return nil, blogError(err, req.GetBlog().GetId(), "blog.id")
+	}
+
+	return &blogpb.UpdateBlogResponse{
+		Blog: dataToBlogPb(data),
+	}, nil
+}
+
+func (s *blogServer) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*blogpb.DeleteBlogResponse, error) {
+	if err := s.store.DeleteBlog(ctx, req.GetBlogId()); err != nil {
+		return nil, blogError(err, req.GetBlogId(), "blog_id")
+	}
+
+	return &blogpb.DeleteBlogResponse{BlogId: req.GetBlogId()}, nil
+}
+
+func (s *blogServer) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_ListBlogServer) error {
+	filter := blogstore.Filter{AuthorID: req.GetAuthorId(), Tag: req.GetTag()}
+	err := s.store.ListBlogs(stream.Context(), filter, func(data *blogstore.Blog) error {
+		return stream.Send(&blogpb.ListBlogResponse{Blog: dataToBlogPb(data)})
+	})
+	return rpcerr.Convert(err)
+}
+
+func (s *blogServer) GetRelatedBlogs(ctx context.Context, req *blogpb.GetRelatedBlogsRequest) (*blogpb.GetRelatedBlogsResponse, error) {
+	if _, err := s.store.ReadBlog(ctx, req.GetBlogId()); err != nil {
+		return nil, blogError(err, req.GetBlogId(), "blog_id")
+	}
+
+	limit := int(req.GetLimit())
+	switch {
+	case limit <= 0:
+		limit = 5
+	case limit > 50:
+		limit = 50
+	}
+
+	res := &blogpb.GetRelatedBlogsResponse{}
+	for _, related := range s.related.Related(req.GetBlogId(), limit) {
+		data, err := s.store.ReadBlog(ctx, related.ID)
+		if errors.Is(err, blogstore.ErrNotFound) {
+			// deleted behind the index's back, skip it
+			continue
+		}
+		if err != nil {
+			return nil, rpcerr.Convert(err)
+		}
+		res.Blogs = append(res.Blogs, &blogpb.RelatedBlog{Blog: dataToBlogPb(data), Score: related.Score})
+	}
+	return res, nil
+}
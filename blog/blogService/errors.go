@@ -0,0 +1,52 @@
+package blogservice
+
+import (
+	"errors"
+	"time"
+
+	blogprivacy "github.com/dipjyotimetia/gogrpc/blog/blogPrivacy"
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/grpc/codes"
+)
+
+// Resource types named in the google.rpc.ResourceInfo of errors.
+const (
+	resourceBlog       = "blog.Blog"
+	resourceAuthor     = "blog.Author"
+	resourceErasureJob = "blog.ErasureJob"
+)
+
+var errNoAuthorData = errors.New("no data held for author")
+
+func init() {
+	rpcerr.Register(blogstore.ErrNotFound, rpcerr.Mapping{Code: codes.NotFound, Reason: "BLOG_NOT_FOUND"})
+	rpcerr.Register(blogstore.ErrInvalidID, rpcerr.Mapping{Code: codes.InvalidArgument, Reason: "INVALID_BLOG_ID"})
+	rpcerr.Register(blogstore.ErrAuthorNotFound, rpcerr.Mapping{Code: codes.NotFound, Reason: "AUTHOR_NOT_FOUND"})
+	rpcerr.Register(blogstore.ErrAuthorExists, rpcerr.Mapping{Code: codes.AlreadyExists, Reason: "AUTHOR_EXISTS"})
+	rpcerr.Register(blogstore.ErrJobNotFound, rpcerr.Mapping{Code: codes.NotFound, Reason: "ERASURE_JOB_NOT_FOUND"})
+	rpcerr.Register(blogprivacy.ErrAnonymousAuthor, rpcerr.Mapping{Code: codes.InvalidArgument, Reason: "ANONYMOUS_AUTHOR"})
+	rpcerr.Register(blogprivacy.ErrModeConflict, rpcerr.Mapping{Code: codes.FailedPrecondition, Reason: "ERASURE_MODE_CONFLICT"})
+	rpcerr.Register(blogstore.ErrUnavailable, rpcerr.Mapping{Code: codes.Unavailable, Reason: "STORE_UNAVAILABLE", RetryDelay: time.Second})
+	rpcerr.Register(errNoAuthorData, rpcerr.Mapping{Code: codes.NotFound, Reason: "AUTHOR_DATA_NOT_FOUND"})
+}
+
+// blogError converts a store error about the blog with the given id,
+// read from the request field at path.
+func blogError(err error, id, path string) error {
+	switch {
+	case errors.Is(err, blogstore.ErrInvalidID):
+		return rpcerr.Convert(err, rpcerr.WithField(path))
+	case errors.Is(err, blogstore.ErrNotFound):
+		return rpcerr.Convert(err, rpcerr.WithResource(resourceBlog, id))
+	}
+	return rpcerr.Convert(err)
+}
+
+// authorError converts a store error about the author with the given id.
+func authorError(err error, id string) error {
+	if errors.Is(err, blogstore.ErrAuthorNotFound) || errors.Is(err, blogstore.ErrAuthorExists) {
+		return rpcerr.Convert(err, rpcerr.WithResource(resourceAuthor, id))
+	}
+	return rpcerr.Convert(err)
+}
@@ -0,0 +1,225 @@
+// Package blogservice implements the blog.BlogService, the
+// blog.AuthorService and the blog.BlogAdminService over a blog store,
+// with the RSS/Atom feeds of the store.
+package blogservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	blogfeed "github.com/dipjyotimetia/gogrpc/blog/blogFeed"
+	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	blogprivacy "github.com/dipjyotimetia/gogrpc/blog/blogPrivacy"
+	blogrelated "github.com/dipjyotimetia/gogrpc/blog/blogRelated"
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/metrics"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
+	"google.golang.org/grpc"
+)
+
+// Settings of the blog service, inlined in the settings of the servers
+// hosting it.
+type Settings struct {
+	FeedBaseURL     string           `config:"feed-base-url" usage:"base URL used for links inside the feeds"`
+	AdminPrincipals []string         `config:"admin-principals" usage:"comma separated client certificate principals allowed to call the blog.BlogAdminService, none when empty"`
+	Store           blogstore.Config `config:",inline"`
+	// Metrics, when set, records the latencies of the MongoDB commands.
+	Metrics *metrics.Registry
+	// Tracer, when set, traces the store operations of traced calls.
+	Tracer *tracing.Tracer
+	// Logger, when set, replaces the default logger.
+	Logger *logging.Logger
+}
+
+// DefaultSettings returns the default settings of the service, using the
+// MongoDB of docker-compose.yml.
+func DefaultSettings() Settings {
+	return Settings{
+		FeedBaseURL: "http://localhost:8080",
+		Store: blogstore.Config{
+			Backend: "mongo",
+			Path:    "blog.json",
+			Mongo: blogstore.MongoConfig{
+				URI:      "mongodb://localhost:27017",
+				Username: "admin",
+				Password: "admin",
+				Database: "mydb",
+			},
+		},
+	}
+}
+
+// Check adds the problems of the settings to p.
+func (c Settings) Check(p *config.Problems) {
+	if u, err := url.Parse(c.FeedBaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+		p.Add("feed-base-url", "%q is not an absolute URL", c.FeedBaseURL)
+	}
+	p.OneOf("store", c.Store.Backend, "mongo", "memory", "file")
+	switch c.Store.Backend {
+	case "mongo":
+		if u, err := url.Parse(c.Store.Mongo.URI); err != nil || (u.Scheme != "mongodb" && u.Scheme != "mongodb+srv") {
+			p.Add("mongo-uri", "%q is not a mongodb:// URI", c.Store.Mongo.URI)
+		}
+		if c.Store.Mongo.Database == "" {
+			p.Add("mongo-database", "a database is required")
+		}
+	case "file":
+		if c.Store.Path == "" {
+			p.Add("store-path", "a file is required by the file store")
+		}
+	}
+}
+
+// RestrictAdmin reserves the blog.BlogAdminService to the admin principals
+// of c, for the mtls interceptors of the servers hosting it: erasing and
+// exporting the data of authors is not open to every client.
+func (c Settings) RestrictAdmin() mtls.Option {
+	return mtls.Restrict(AdminServiceName, c.AdminPrincipals...)
+}
+
+// AdminServiceName is the name of the service reserved to admins.
+const AdminServiceName = "blog.BlogAdminService"
+
+// ServiceNames are the names of the services in health checks.
+var ServiceNames = []string{"blog.BlogService", "blog.AuthorService", AdminServiceName}
+
+// Service is the blog services over an open store.
+type Service struct {
+	store       blogstore.Store
+	feedBaseURL string
+	eraser      *blogprivacy.Eraser
+	stopEraser  context.CancelFunc
+	log         *logging.Logger
+
+	blog   *blogServer
+	author *authorServer
+	admin  *adminServer
+}
+
+// Open opens the store, indexes its blogs for GetRelatedBlogs and starts
+// the worker of erasure jobs. ctx bounds the opening only.
+func Open(ctx context.Context, c Settings) (*Service, error) {
+	log := c.Logger
+	if log == nil {
+		log = logging.Default()
+	}
+	if c.Store.Backend == "mongo" {
+		log.Info("connecting to mongodb")
+	}
+	if c.Metrics != nil || c.Tracer != nil {
+		c.Store.Mongo.Commands = mongoCommands(c.Metrics, c.Tracer)
+	}
+	store, err := blogstore.Open(ctx, c.Store)
+	if err != nil {
+		return nil, fmt.Errorf("blog store failed %w", err)
+	}
+	related := blogrelated.New()
+	if err := related.Rebuild(ctx, store); err != nil {
+		store.Close(context.Background())
+		return nil, fmt.Errorf("related blogs index failed %w", err)
+	}
+	if c.Tracer != nil {
+		store = blogstore.Instrument(store, traceStore(c.Tracer, c.Store.Backend))
+	}
+	store = blogstore.Observe(store, related)
+
+	eraserCtx, stopEraser := context.WithCancel(logging.NewContext(context.Background(), log))
+	eraser := blogprivacy.NewEraser(store)
+	eraser.Start(eraserCtx)
+
+	return &Service{
+		store:       store,
+		feedBaseURL: c.FeedBaseURL,
+		eraser:      eraser,
+		stopEraser:  stopEraser,
+		log:         log,
+		blog:        &blogServer{store: store, related: related},
+		author:      &authorServer{store: store},
+		admin:       &adminServer{store: store, eraser: eraser},
+	}, nil
+}
+
+// mongoCommands times the MongoDB commands, and traces those of traced
+// calls.
+func mongoCommands(r *metrics.Registry, t *tracing.Tracer) func(context.Context, string) func(error) {
+	var seconds *metrics.Histogram
+	if r != nil {
+		seconds = r.NewHistogram("blog_store_mongo_command_seconds", "Duration of the MongoDB commands of the blog store.", metrics.DefaultBuckets, "command", "result")
+	}
+	return func(ctx context.Context, command string) func(error) {
+		var span *tracing.Span
+		if tracing.SpanFromContext(ctx) != nil {
+			_, span = t.Start(ctx, "mongodb."+command, tracing.Client)
+			span.SetAttribute("db.system", "mongodb")
+			span.SetAttribute("db.operation", command)
+		}
+		start := time.Now()
+		return func(err error) {
+			span.End(err)
+			if seconds == nil {
+				return
+			}
+			result := "ok"
+			if err != nil {
+				result = "error"
+			}
+			seconds.Observe(time.Since(start).Seconds(), command, result)
+		}
+	}
+}
+
+// traceStore starts a child span for each operation of a traced call; the
+// background work, like health checks and the eraser, is left out.
+func traceStore(t *tracing.Tracer, backend string) blogstore.Hook {
+	return func(ctx context.Context, op string) (context.Context, func(error)) {
+		if tracing.SpanFromContext(ctx) == nil {
+			return ctx, func(error) {}
+		}
+		system := backend
+		if backend == "mongo" {
+			system = "mongodb"
+		}
+		ctx, span := t.Start(ctx, "blogstore."+op, tracing.Internal)
+		span.SetAttribute("db.system", system)
+		span.SetAttribute("db.operation", op)
+		return ctx, span.End
+	}
+}
+
+// Register registers the three services on s.
+func (s *Service) Register(g *grpc.Server) {
+	s.RegisterPublic(g)
+	blogpb.RegisterBlogAdminServiceServer(g, s.admin)
+}
+
+// RegisterPublic registers the services open to HTTP clients on s: the
+// admin service stays gRPC only.
+func (s *Service) RegisterPublic(g *grpc.Server) {
+	blogpb.RegisterBlogServiceServer(g, s.blog)
+	blogpb.RegisterAuthorServiceServer(g, s.author)
+}
+
+// Ping checks the store can be reached.
+func (s *Service) Ping(ctx context.Context) error {
+	return s.store.Ping(ctx)
+}
+
+// Feeds returns the handler of the /feeds/ RSS and Atom feeds.
+func (s *Service) Feeds() http.Handler {
+	return &blogfeed.Handler{Store: s.store, BaseURL: s.feedBaseURL, Log: s.log}
+}
+
+// Close waits for the erasure worker and closes the store.
+func (s *Service) Close(ctx context.Context) error {
+	s.log.Info("stopping the erasure worker")
+	s.stopEraser()
+	s.eraser.Wait()
+	s.log.Info("closing the blog store")
+	return s.store.Close(ctx)
+}
@@ -0,0 +1,134 @@
+package blogstore
+
+import (
+	"context"
+	"time"
+)
+
+// A Hook is called as every operation of an instrumented store starts,
+// with the name of the operation, like "CreateBlog". It returns the
+// context the operation runs with and the function told its outcome.
+type Hook func(ctx context.Context, op string) (context.Context, func(err error))
+
+type instrumentedStore struct {
+	Store
+	hook Hook
+}
+
+// Instrument wraps s so that hook learns about every operation, for
+// tracing or timing them.
+func Instrument(s Store, hook Hook) Store {
+	return &instrumentedStore{Store: s, hook: hook}
+}
+
+func (s *instrumentedStore) CreateBlog(ctx context.Context, b *Blog) (*Blog, error) {
+	ctx, done := s.hook(ctx, "CreateBlog")
+	res, err := s.Store.CreateBlog(ctx, b)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) ReadBlog(ctx context.Context, id string) (*Blog, error) {
+	ctx, done := s.hook(ctx, "ReadBlog")
+	res, err := s.Store.ReadBlog(ctx, id)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) UpdateBlog(ctx context.Context, b *Blog) (*Blog, error) {
+	ctx, done := s.hook(ctx, "UpdateBlog")
+	res, err := s.Store.UpdateBlog(ctx, b)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) DeleteBlog(ctx context.Context, id string) error {
+	ctx, done := s.hook(ctx, "DeleteBlog")
+	err := s.Store.DeleteBlog(ctx, id)
+	done(err)
+	return err
+}
+
+func (s *instrumentedStore) ListBlogs(ctx context.Context, filter Filter, fn func(*Blog) error) error {
+	ctx, done := s.hook(ctx, "ListBlogs")
+	err := s.Store.ListBlogs(ctx, filter, fn)
+	done(err)
+	return err
+}
+
+func (s *instrumentedStore) LastBlogDeletion(ctx context.Context) (time.Time, error) {
+	ctx, done := s.hook(ctx, "LastBlogDeletion")
+	res, err := s.Store.LastBlogDeletion(ctx)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) CreateAuthor(ctx context.Context, a *Author) (*Author, error) {
+	ctx, done := s.hook(ctx, "CreateAuthor")
+	res, err := s.Store.CreateAuthor(ctx, a)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) ReadAuthor(ctx context.Context, id string) (*Author, error) {
+	ctx, done := s.hook(ctx, "ReadAuthor")
+	res, err := s.Store.ReadAuthor(ctx, id)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) UpdateAuthor(ctx context.Context, a *Author) (*Author, error) {
+	ctx, done := s.hook(ctx, "UpdateAuthor")
+	res, err := s.Store.UpdateAuthor(ctx, a)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) DeleteAuthor(ctx context.Context, id string) error {
+	ctx, done := s.hook(ctx, "DeleteAuthor")
+	err := s.Store.DeleteAuthor(ctx, id)
+	done(err)
+	return err
+}
+
+func (s *instrumentedStore) ListAuthors(ctx context.Context, fn func(*Author) error) error {
+	ctx, done := s.hook(ctx, "ListAuthors")
+	err := s.Store.ListAuthors(ctx, fn)
+	done(err)
+	return err
+}
+
+func (s *instrumentedStore) CreateErasureJob(ctx context.Context, j *ErasureJob) (*ErasureJob, error) {
+	ctx, done := s.hook(ctx, "CreateErasureJob")
+	res, err := s.Store.CreateErasureJob(ctx, j)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) ReadErasureJob(ctx context.Context, id string) (*ErasureJob, error) {
+	ctx, done := s.hook(ctx, "ReadErasureJob")
+	res, err := s.Store.ReadErasureJob(ctx, id)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) UpdateErasureJob(ctx context.Context, j *ErasureJob) (*ErasureJob, error) {
+	ctx, done := s.hook(ctx, "UpdateErasureJob")
+	res, err := s.Store.UpdateErasureJob(ctx, j)
+	done(err)
+	return res, err
+}
+
+func (s *instrumentedStore) ListErasureJobs(ctx context.Context, fn func(*ErasureJob) error) error {
+	ctx, done := s.hook(ctx, "ListErasureJobs")
+	err := s.Store.ListErasureJobs(ctx, fn)
+	done(err)
+	return err
+}
+
+func (s *instrumentedStore) Ping(ctx context.Context) error {
+	ctx, done := s.hook(ctx, "Ping")
+	err := s.Store.Ping(ctx)
+	done(err)
+	return err
+}
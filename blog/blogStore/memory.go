@@ -0,0 +1,517 @@
+package blogstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryStore keeps blogs in process memory. It is meant for local
+// development and for running the service without MongoDB. A store opened
+// with OpenFileStore also writes every change through to a JSON file.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	blogs   map[string]*Blog
+	authors map[string]*Author
+	jobs    map[string]*ErasureJob
+	path    string
+	// deleted is when a blog was last deleted
+	deleted time.Time
+}
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		blogs:   map[string]*Blog{},
+		authors: map[string]*Author{},
+		jobs:    map[string]*ErasureJob{},
+	}
+}
+
+// fileSnapshot is the on-disk layout of a file store.
+type fileSnapshot struct {
+	Blogs       []*Blog       `json:"blogs"`
+	Authors     []*Author     `json:"authors"`
+	ErasureJobs []*ErasureJob `json:"erasure_jobs"`
+	BlogDeleted *time.Time    `json:"blog_deleted,omitempty"`
+}
+
+// OpenFileStore returns a memory store persisted to path, loading the
+// existing content if the file is present.
+func OpenFileStore(path string) (*MemoryStore, error) {
+	s := NewMemoryStore()
+	s.path = path
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var snap fileSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, err
+	}
+	for _, b := range snap.Blogs {
+		s.blogs[b.ID] = b
+	}
+	for _, a := range snap.Authors {
+		s.authors[a.ID] = a
+	}
+	for _, j := range snap.ErasureJobs {
+		s.jobs[j.ID] = j
+	}
+	if snap.BlogDeleted != nil {
+		s.deleted = *snap.BlogDeleted
+	}
+	return s, nil
+}
+
+// commit persists the store after a change made under s.mu. If that fails
+// undo reverts the change so memory never runs ahead of the file.
+func (s *MemoryStore) commit(undo func()) error {
+	if s.path == "" {
+		return nil
+	}
+	snap := fileSnapshot{}
+	for _, b := range s.blogs {
+		snap.Blogs = append(snap.Blogs, b)
+	}
+	for _, a := range s.authors {
+		snap.Authors = append(snap.Authors, a)
+	}
+	for _, j := range s.jobs {
+		snap.ErasureJobs = append(snap.ErasureJobs, j)
+	}
+	if !s.deleted.IsZero() {
+		deleted := s.deleted
+		snap.BlogDeleted = &deleted
+	}
+	sort.Slice(snap.Blogs, func(i, j int) bool { return snap.Blogs[i].ID < snap.Blogs[j].ID })
+	sort.Slice(snap.Authors, func(i, j int) bool { return snap.Authors[i].ID < snap.Authors[j].ID })
+	sort.Slice(snap.ErasureJobs, func(i, j int) bool { return snap.ErasureJobs[i].ID < snap.ErasureJobs[j].ID })
+
+	err := writeFileAtomic(s.path, snap)
+	if err != nil {
+		undo()
+	}
+	return err
+}
+
+func writeFileAtomic(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func copyBlog(b *Blog) *Blog {
+	c := *b
+	c.Tags = append([]string(nil), b.Tags...)
+	return &c
+}
+
+func (s *MemoryStore) CreateBlog(ctx context.Context, b *Blog) (*Blog, error) {
+	data := copyBlog(b)
+	// ids look exactly like the ones Mongo hands out
+	data.ID = primitive.NewObjectID().Hex()
+	data.CreatedAt = now()
+	data.UpdatedAt = data.CreatedAt
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blogs[data.ID] = data
+	if err := s.commit(func() { delete(s.blogs, data.ID) }); err != nil {
+		return nil, err
+	}
+	return copyBlog(data), nil
+}
+
+func (s *MemoryStore) ReadBlog(ctx context.Context, id string) (*Blog, error) {
+	if _, err := parseID(id); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.blogs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return copyBlog(data), nil
+}
+
+func (s *MemoryStore) UpdateBlog(ctx context.Context, b *Blog) (*Blog, error) {
+	if _, err := parseID(b.ID); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.blogs[b.ID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	data := copyBlog(b)
+	data.CreatedAt = old.CreatedAt
+	data.UpdatedAt = now()
+	s.blogs[b.ID] = data
+	if err := s.commit(func() { s.blogs[b.ID] = old }); err != nil {
+		return nil, err
+	}
+	return copyBlog(data), nil
+}
+
+func (s *MemoryStore) DeleteBlog(ctx context.Context, id string) error {
+	if _, err := parseID(id); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.blogs[id]
+	if !ok {
+		return ErrNotFound
+	}
+	delete(s.blogs, id)
+	deleted := s.deleted
+	s.deleted = now()
+	return s.commit(func() { s.blogs[id], s.deleted = old, deleted })
+}
+
+func (s *MemoryStore) LastBlogDeletion(ctx context.Context) (time.Time, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.deleted, nil
+}
+
+func (s *MemoryStore) ListBlogs(ctx context.Context, filter Filter, fn func(*Blog) error) error {
+	// snapshot under the lock so fn can call back into the store
+	s.mu.RLock()
+	var blogs []*Blog
+	for _, b := range s.blogs {
+		if filter.Match(b) {
+			blogs = append(blogs, copyBlog(b))
+		}
+	}
+	s.mu.RUnlock()
+
+	if filter.Recent {
+		sort.Slice(blogs, func(i, j int) bool {
+			if !blogs[i].UpdatedAt.Equal(blogs[j].UpdatedAt) {
+				return blogs[i].UpdatedAt.After(blogs[j].UpdatedAt)
+			}
+			return blogs[i].ID > blogs[j].ID
+		})
+	} else {
+		// ObjectIDs start with a timestamp, so this is creation order
+		sort.Slice(blogs, func(i, j int) bool { return blogs[i].ID < blogs[j].ID })
+	}
+	if filter.Limit > 0 && len(blogs) > filter.Limit {
+		blogs = blogs[:filter.Limit]
+	}
+	for _, b := range blogs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) CreateAuthor(ctx context.Context, a *Author) (*Author, error) {
+	data := *a
+	if data.ID == "" {
+		data.ID = primitive.NewObjectID().Hex()
+	}
+	data.CreatedAt = now()
+	data.UpdatedAt = data.CreatedAt
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.authors[data.ID]; ok {
+		return nil, ErrAuthorExists
+	}
+	s.authors[data.ID] = &data
+	if err := s.commit(func() { delete(s.authors, data.ID) }); err != nil {
+		return nil, err
+	}
+	res := data
+	return &res, nil
+}
+
+func (s *MemoryStore) ReadAuthor(ctx context.Context, id string) (*Author, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.authors[id]
+	if !ok {
+		return nil, ErrAuthorNotFound
+	}
+	res := *data
+	return &res, nil
+}
+
+func (s *MemoryStore) UpdateAuthor(ctx context.Context, a *Author) (*Author, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.authors[a.ID]
+	if !ok {
+		return nil, ErrAuthorNotFound
+	}
+	data := *a
+	data.CreatedAt = old.CreatedAt
+	data.UpdatedAt = now()
+	s.authors[a.ID] = &data
+	if err := s.commit(func() { s.authors[a.ID] = old }); err != nil {
+		return nil, err
+	}
+	res := data
+	return &res, nil
+}
+
+func (s *MemoryStore) DeleteAuthor(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.authors[id]
+	if !ok {
+		return ErrAuthorNotFound
+	}
+	delete(s.authors, id)
+	return s.commit(func() { s.authors[id] = old })
+}
+
+func (s *MemoryStore) ListAuthors(ctx context.Context, fn func(*Author) error) error {
+	s.mu.RLock()
+	authors := make([]*Author, 0, len(s.authors))
+	for _, a := range s.authors {
+		c := *a
+		authors = append(authors, &c)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(authors, func(i, j int) bool { return authors[i].ID < authors[j].ID })
+	for _, a := range authors {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) CreateErasureJob(ctx context.Context, j *ErasureJob) (*ErasureJob, error) {
+	data := *j
+	data.ID = primitive.NewObjectID().Hex()
+	data.CreatedAt = now()
+	data.UpdatedAt = data.CreatedAt
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if Unfinished(data.State) {
+		for _, existing := range s.jobs {
+			if existing.AuthorID == data.AuthorID && Unfinished(existing.State) {
+				res := *existing
+				return &res, ErrJobExists
+			}
+		}
+	}
+	s.jobs[data.ID] = &data
+	if err := s.commit(func() { delete(s.jobs, data.ID) }); err != nil {
+		return nil, err
+	}
+	res := data
+	return &res, nil
+}
+
+func (s *MemoryStore) ReadErasureJob(ctx context.Context, id string) (*ErasureJob, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.jobs[id]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	res := *data
+	return &res, nil
+}
+
+func (s *MemoryStore) UpdateErasureJob(ctx context.Context, j *ErasureJob) (*ErasureJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.jobs[j.ID]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	data := *j
+	data.CreatedAt = old.CreatedAt
+	data.UpdatedAt = now()
+	s.jobs[j.ID] = &data
+	if err := s.commit(func() { s.jobs[j.ID] = old }); err != nil {
+		return nil, err
+	}
+	res := data
+	return &res, nil
+}
+
+func (s *MemoryStore) ListErasureJobs(ctx context.Context, fn func(*ErasureJob) error) error {
+	s.mu.RLock()
+	jobs := make([]*ErasureJob, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		c := *j
+		jobs = append(jobs, &c)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	for _, j := range jobs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(j); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) ImportBlogs(ctx context.Context, blogs []*Blog) error {
+	for _, b := range blogs {
+		if _, err := parseID(b.ID); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range blogs {
+		if _, ok := s.blogs[b.ID]; ok {
+			return ErrNotEmpty
+		}
+	}
+	for _, b := range blogs {
+		s.blogs[b.ID] = copyBlog(b)
+	}
+	return s.commit(func() {
+		for _, b := range blogs {
+			delete(s.blogs, b.ID)
+		}
+	})
+}
+
+func (s *MemoryStore) ImportAuthors(ctx context.Context, authors []*Author) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range authors {
+		if _, ok := s.authors[a.ID]; ok {
+			return ErrNotEmpty
+		}
+	}
+	for _, a := range authors {
+		data := *a
+		s.authors[a.ID] = &data
+	}
+	return s.commit(func() {
+		for _, a := range authors {
+			delete(s.authors, a.ID)
+		}
+	})
+}
+
+func (s *MemoryStore) ImportErasureJobs(ctx context.Context, jobs []*ErasureJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, j := range jobs {
+		if _, ok := s.jobs[j.ID]; ok {
+			return ErrNotEmpty
+		}
+	}
+	for _, j := range jobs {
+		data := *j
+		s.jobs[j.ID] = &data
+	}
+	return s.commit(func() {
+		for _, j := range jobs {
+			delete(s.jobs, j.ID)
+		}
+	})
+}
+
+// Ping always succeeds, the store lives in the process.
+func (s *MemoryStore) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (s *MemoryStore) Close(ctx context.Context) error {
+	return nil
+}
+
+// Snapshot returns an in-memory copy of the store.
+func (s *MemoryStore) Snapshot(ctx context.Context) (Store, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c := NewMemoryStore()
+	for id, b := range s.blogs {
+		c.blogs[id] = copyBlog(b)
+	}
+	for id, a := range s.authors {
+		data := *a
+		c.authors[id] = &data
+	}
+	for id, j := range s.jobs {
+		data := *j
+		c.jobs[id] = &data
+	}
+	c.deleted = s.deleted
+	return c, nil
+}
+
+// memoryStaging imports into a store of its own, swapped in on Commit.
+type memoryStaging struct {
+	*MemoryStore
+	into *MemoryStore
+}
+
+func (s *MemoryStore) Stage(ctx context.Context) (Staging, error) {
+	return &memoryStaging{MemoryStore: NewMemoryStore(), into: s}, nil
+}
+
+func (st *memoryStaging) Commit(ctx context.Context) error {
+	s := st.into
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.blogs) > 0 || len(s.authors) > 0 || len(s.jobs) > 0 {
+		return ErrNotEmpty
+	}
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	blogs, authors, jobs := s.blogs, s.authors, s.jobs
+	s.blogs, s.authors, s.jobs = st.blogs, st.authors, st.jobs
+	return s.commit(func() { s.blogs, s.authors, s.jobs = blogs, authors, jobs })
+}
+
+func (st *memoryStaging) Abort(ctx context.Context) error {
+	return nil
+}
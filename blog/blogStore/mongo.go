@@ -0,0 +1,756 @@
+package blogstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+type blogItem struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	AuthorId  string             `bson:"author_id"`
+	Content   string             `bson:"content"`
+	Title     string             `bson:"title"`
+	Tags      []string           `bson:"tags,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+func (i *blogItem) toBlog() *Blog {
+	return &Blog{
+		ID:        i.Id.Hex(),
+		AuthorID:  i.AuthorId,
+		Title:     i.Title,
+		Content:   i.Content,
+		Tags:      i.Tags,
+		CreatedAt: i.CreatedAt.UTC(),
+		UpdatedAt: i.UpdatedAt.UTC(),
+	}
+}
+
+type authorItem struct {
+	Id          string    `bson:"_id"`
+	DisplayName string    `bson:"display_name"`
+	Bio         string    `bson:"bio"`
+	AvatarURL   string    `bson:"avatar_url"`
+	CreatedAt   time.Time `bson:"created_at"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+func (i *authorItem) toAuthor() *Author {
+	return &Author{
+		ID:          i.Id,
+		DisplayName: i.DisplayName,
+		Bio:         i.Bio,
+		AvatarURL:   i.AvatarURL,
+		CreatedAt:   i.CreatedAt.UTC(),
+		UpdatedAt:   i.UpdatedAt.UTC(),
+	}
+}
+
+type jobItem struct {
+	Id             primitive.ObjectID `bson:"_id,omitempty"`
+	AuthorId       string             `bson:"author_id"`
+	Mode           string             `bson:"mode"`
+	State          string             `bson:"state"`
+	BlogsProcessed int64              `bson:"blogs_processed"`
+	AuthorRemoved  bool               `bson:"author_removed"`
+	Error          string             `bson:"error,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+	CompletedAt    time.Time          `bson:"completed_at,omitempty"`
+	// Unfinished is set on pending and running jobs only, for the unique
+	// index allowing one of them per author.
+	Unfinished bool `bson:"unfinished,omitempty"`
+}
+
+func (i *jobItem) toJob() *ErasureJob {
+	return &ErasureJob{
+		ID:             i.Id.Hex(),
+		AuthorID:       i.AuthorId,
+		Mode:           i.Mode,
+		State:          i.State,
+		BlogsProcessed: i.BlogsProcessed,
+		AuthorRemoved:  i.AuthorRemoved,
+		Error:          i.Error,
+		CreatedAt:      i.CreatedAt.UTC(),
+		UpdatedAt:      i.UpdatedAt.UTC(),
+		CompletedAt:    i.CompletedAt.UTC(),
+	}
+}
+
+// MongoStore keeps blogs in the "blog" collection, authors in the "author"
+// collection and erasure jobs in the "erasure_job" collection of a MongoDB
+// database. The "store_meta" collection records when blogs were deleted.
+type MongoStore struct {
+	client     *mongo.Client
+	collection *mongo.Collection
+	authors    *mongo.Collection
+	jobs       *mongo.Collection
+	meta       *mongo.Collection
+
+	indexMu  sync.Mutex
+	jobIndex bool
+}
+
+// blogsMetaID is the store_meta document about the blog collection.
+const blogsMetaID = "blogs"
+
+// MongoConfig describes how to reach MongoDB.
+type MongoConfig struct {
+	URI      string `config:"uri" usage:"MongoDB connection string"`
+	Username string `config:"user" usage:"MongoDB user"`
+	Password string `config:"password,secret" usage:"MongoDB password"`
+	Database string `config:"database" usage:"MongoDB database"`
+	// Commands, when set, is called as every command sent to MongoDB
+	// starts, with the context of the operation, and returns the function
+	// told its outcome.
+	Commands func(ctx context.Context, command string) func(err error)
+}
+
+// NewMongoStore connects to MongoDB and returns a store backed by it.
+func NewMongoStore(ctx context.Context, cfg MongoConfig) (*MongoStore, error) {
+	opts := options.Client().ApplyURI(cfg.URI)
+	if cfg.Username != "" {
+		opts.SetAuth(options.Credential{
+			Username: cfg.Username,
+			Password: cfg.Password,
+		})
+	}
+	if cfg.Commands != nil {
+		opts.SetMonitor(commandMonitor(cfg.Commands))
+	}
+	client, err := mongo.NewClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Connect(ctx); err != nil {
+		return nil, err
+	}
+	db := client.Database(cfg.Database)
+	return &MongoStore{
+		client:     client,
+		collection: db.Collection("blog"),
+		authors:    db.Collection("author"),
+		jobs:       db.Collection("erasure_job"),
+		meta:       db.Collection("store_meta"),
+	}, nil
+}
+
+func commandMonitor(start func(context.Context, string) func(error)) *event.CommandMonitor {
+	var mu sync.Mutex
+	running := map[int64]func(error){}
+	finish := func(id int64, err error) {
+		mu.Lock()
+		done, ok := running[id]
+		delete(running, id)
+		mu.Unlock()
+		if ok {
+			done(err)
+		}
+	}
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			done := start(ctx, e.CommandName)
+			mu.Lock()
+			running[e.RequestID] = done
+			mu.Unlock()
+		},
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			finish(e.RequestID, nil)
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			finish(e.RequestID, errors.New(e.Failure))
+		},
+	}
+}
+
+func parseID(id string) (primitive.ObjectID, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return oid, ErrInvalidID
+	}
+	return oid, nil
+}
+
+func (s *MongoStore) CreateBlog(ctx context.Context, b *Blog) (*Blog, error) {
+	ts := now()
+	data := &blogItem{
+		AuthorId:  b.AuthorID,
+		Content:   b.Content,
+		Title:     b.Title,
+		Tags:      b.Tags,
+		CreatedAt: ts,
+		UpdatedAt: ts,
+	}
+	res, err := s.collection.InsertOne(ctx, data)
+	if err != nil {
+		return nil, classify(err)
+	}
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("cannot convert inserted id to OID")
+	}
+	data.Id = oid
+	return data.toBlog(), nil
+}
+
+func (s *MongoStore) ReadBlog(ctx context.Context, id string) (*Blog, error) {
+	oid, err := parseID(id)
+	if err != nil {
+		return nil, classify(err)
+	}
+	data := &blogItem{}
+	if err := s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(data); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrNotFound
+		}
+		return nil, classify(err)
+	}
+	return data.toBlog(), nil
+}
+
+func (s *MongoStore) UpdateBlog(ctx context.Context, b *Blog) (*Blog, error) {
+	oid, err := parseID(b.ID)
+	if err != nil {
+		return nil, classify(err)
+	}
+	filter := bson.M{"_id": oid}
+
+	data := &blogItem{}
+	if err := s.collection.FindOne(ctx, filter).Decode(data); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrNotFound
+		}
+		return nil, classify(err)
+	}
+
+	data.AuthorId = b.AuthorID
+	data.Content = b.Content
+	data.Title = b.Title
+	data.Tags = b.Tags
+	data.UpdatedAt = now()
+
+	res, err := s.collection.ReplaceOne(ctx, filter, data)
+	if err != nil {
+		return nil, classify(err)
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrNotFound
+	}
+	return data.toBlog(), nil
+}
+
+func (s *MongoStore) DeleteBlog(ctx context.Context, id string) error {
+	oid, err := parseID(id)
+	if err != nil {
+		return classify(err)
+	}
+	// marked first: a marker without a deletion only costs a feed reader
+	// a full fetch, a deletion without a marker serves it a stale feed
+	_, err = s.meta.UpdateOne(ctx, bson.M{"_id": blogsMetaID}, bson.M{"$max": bson.M{"deleted_at": now()}}, options.Update().SetUpsert(true))
+	if err != nil {
+		return classify(err)
+	}
+	res, err := s.collection.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return classify(err)
+	}
+	if res.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *MongoStore) ListBlogs(ctx context.Context, filter Filter, fn func(*Blog) error) error {
+	query := bson.M{}
+	if filter.AuthorID != "" {
+		query["author_id"] = filter.AuthorID
+	}
+	if filter.Tag != "" {
+		query["tags"] = filter.Tag
+	}
+	opts := options.Find()
+	if filter.Recent {
+		opts.SetSort(bson.D{{Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}})
+	}
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+	cur, err := s.collection.Find(ctx, query, opts)
+	if err != nil {
+		return classify(err)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		data := &blogItem{}
+		if err := cur.Decode(data); err != nil {
+			return classify(err)
+		}
+		if err := fn(data.toBlog()); err != nil {
+			return classify(err)
+		}
+	}
+	return cur.Err()
+}
+
+func (s *MongoStore) LastBlogDeletion(ctx context.Context) (time.Time, error) {
+	var meta struct {
+		DeletedAt time.Time `bson:"deleted_at"`
+	}
+	err := s.meta.FindOne(ctx, bson.M{"_id": blogsMetaID}).Decode(&meta)
+	if err == mongo.ErrNoDocuments {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, classify(err)
+	}
+	return meta.DeletedAt.UTC(), nil
+}
+
+func (s *MongoStore) CreateAuthor(ctx context.Context, a *Author) (*Author, error) {
+	ts := now()
+	data := &authorItem{
+		Id:          a.ID,
+		DisplayName: a.DisplayName,
+		Bio:         a.Bio,
+		AvatarURL:   a.AvatarURL,
+		CreatedAt:   ts,
+		UpdatedAt:   ts,
+	}
+	if data.Id == "" {
+		data.Id = primitive.NewObjectID().Hex()
+	}
+	if _, err := s.authors.InsertOne(ctx, data); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrAuthorExists
+		}
+		return nil, classify(err)
+	}
+	return data.toAuthor(), nil
+}
+
+func (s *MongoStore) ReadAuthor(ctx context.Context, id string) (*Author, error) {
+	data := &authorItem{}
+	if err := s.authors.FindOne(ctx, bson.M{"_id": id}).Decode(data); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrAuthorNotFound
+		}
+		return nil, classify(err)
+	}
+	return data.toAuthor(), nil
+}
+
+func (s *MongoStore) UpdateAuthor(ctx context.Context, a *Author) (*Author, error) {
+	filter := bson.M{"_id": a.ID}
+	data := &authorItem{}
+	if err := s.authors.FindOne(ctx, filter).Decode(data); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrAuthorNotFound
+		}
+		return nil, classify(err)
+	}
+
+	data.DisplayName = a.DisplayName
+	data.Bio = a.Bio
+	data.AvatarURL = a.AvatarURL
+	data.UpdatedAt = now()
+
+	res, err := s.authors.ReplaceOne(ctx, filter, data)
+	if err != nil {
+		return nil, classify(err)
+	}
+	if res.MatchedCount == 0 {
+		return nil, ErrAuthorNotFound
+	}
+	return data.toAuthor(), nil
+}
+
+func (s *MongoStore) DeleteAuthor(ctx context.Context, id string) error {
+	res, err := s.authors.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return classify(err)
+	}
+	if res.DeletedCount == 0 {
+		return ErrAuthorNotFound
+	}
+	return nil
+}
+
+func (s *MongoStore) ListAuthors(ctx context.Context, fn func(*Author) error) error {
+	cur, err := s.authors.Find(ctx, bson.M{})
+	if err != nil {
+		return classify(err)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		data := &authorItem{}
+		if err := cur.Decode(data); err != nil {
+			return classify(err)
+		}
+		if err := fn(data.toAuthor()); err != nil {
+			return classify(err)
+		}
+	}
+	return cur.Err()
+}
+
+// ensureJobIndex creates, once, the unique index allowing one unfinished
+// job per author, marking the unfinished jobs stored without the field.
+// It is created on first use so the store opens with MongoDB down.
+func (s *MongoStore) ensureJobIndex(ctx context.Context) error {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+	if s.jobIndex {
+		return nil
+	}
+	_, err := s.jobs.UpdateMany(ctx,
+		bson.M{"state": bson.M{"$in": bson.A{JobPending, JobRunning}}, "unfinished": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"unfinished": true}})
+	if err != nil {
+		return classify(err)
+	}
+	if err := createJobIndex(ctx, s.jobs); err != nil {
+		return err
+	}
+	s.jobIndex = true
+	return nil
+}
+
+func createJobIndex(ctx context.Context, jobs *mongo.Collection) error {
+	_, err := jobs.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "author_id", Value: 1}},
+		Options: options.Index().
+			SetName("unfinished_author").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"unfinished": true}),
+	})
+	return classify(err)
+}
+
+func (s *MongoStore) CreateErasureJob(ctx context.Context, j *ErasureJob) (*ErasureJob, error) {
+	if err := s.ensureJobIndex(ctx); err != nil {
+		return nil, err
+	}
+	ts := now()
+	data := &jobItem{
+		AuthorId:   j.AuthorID,
+		Mode:       j.Mode,
+		State:      j.State,
+		CreatedAt:  ts,
+		UpdatedAt:  ts,
+		Unfinished: Unfinished(j.State),
+	}
+	res, err := s.jobs.InsertOne(ctx, data)
+	if mongo.IsDuplicateKeyError(err) {
+		existing := &jobItem{}
+		err := s.jobs.FindOne(ctx, bson.M{"author_id": j.AuthorID, "unfinished": true}).Decode(existing)
+		if err != nil {
+			// finished between the insert and the read
+			return nil, classify(err)
+		}
+		return existing.toJob(), ErrJobExists
+	}
+	if err != nil {
+		return nil, classify(err)
+	}
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, errors.New("cannot convert inserted id to OID")
+	}
+	data.Id = oid
+	return data.toJob(), nil
+}
+
+func (s *MongoStore) ReadErasureJob(ctx context.Context, id string) (*ErasureJob, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrJobNotFound
+	}
+	data := &jobItem{}
+	if err := s.jobs.FindOne(ctx, bson.M{"_id": oid}).Decode(data); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrJobNotFound
+		}
+		return nil, classify(err)
+	}
+	return data.toJob(), nil
+}
+
+func (s *MongoStore) UpdateErasureJob(ctx context.Context, j *ErasureJob) (*ErasureJob, error) {
+	oid, err := primitive.ObjectIDFromHex(j.ID)
+	if err != nil {
+		return nil, ErrJobNotFound
+	}
+	filter := bson.M{"_id": oid}
+	data := &jobItem{}
+	if err := s.jobs.FindOne(ctx, filter).Decode(data); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrJobNotFound
+		}
+		return nil, classify(err)
+	}
+
+	data.State = j.State
+	data.BlogsProcessed = j.BlogsProcessed
+	data.AuthorRemoved = j.AuthorRemoved
+	data.Error = j.Error
+	data.CompletedAt = j.CompletedAt
+	data.UpdatedAt = now()
+	data.Unfinished = Unfinished(j.State)
+
+	if _, err := s.jobs.ReplaceOne(ctx, filter, data); err != nil {
+		return nil, classify(err)
+	}
+	return data.toJob(), nil
+}
+
+func (s *MongoStore) ListErasureJobs(ctx context.Context, fn func(*ErasureJob) error) error {
+	cur, err := s.jobs.Find(ctx, bson.M{})
+	if err != nil {
+		return classify(err)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		data := &jobItem{}
+		if err := cur.Decode(data); err != nil {
+			return classify(err)
+		}
+		if err := fn(data.toJob()); err != nil {
+			return classify(err)
+		}
+	}
+	return cur.Err()
+}
+
+func (s *MongoStore) ImportBlogs(ctx context.Context, blogs []*Blog) error {
+	docs := make([]interface{}, 0, len(blogs))
+	for _, b := range blogs {
+		oid, err := parseID(b.ID)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, &blogItem{
+			Id:        oid,
+			AuthorId:  b.AuthorID,
+			Content:   b.Content,
+			Title:     b.Title,
+			Tags:      b.Tags,
+			CreatedAt: b.CreatedAt,
+			UpdatedAt: b.UpdatedAt,
+		})
+	}
+	return insertMany(ctx, s.collection, docs)
+}
+
+func (s *MongoStore) ImportAuthors(ctx context.Context, authors []*Author) error {
+	docs := make([]interface{}, 0, len(authors))
+	for _, a := range authors {
+		docs = append(docs, &authorItem{
+			Id:          a.ID,
+			DisplayName: a.DisplayName,
+			Bio:         a.Bio,
+			AvatarURL:   a.AvatarURL,
+			CreatedAt:   a.CreatedAt,
+			UpdatedAt:   a.UpdatedAt,
+		})
+	}
+	return insertMany(ctx, s.authors, docs)
+}
+
+func (s *MongoStore) ImportErasureJobs(ctx context.Context, jobs []*ErasureJob) error {
+	docs := make([]interface{}, 0, len(jobs))
+	for _, j := range jobs {
+		oid, err := primitive.ObjectIDFromHex(j.ID)
+		if err != nil {
+			return ErrInvalidID
+		}
+		docs = append(docs, &jobItem{
+			Id:             oid,
+			AuthorId:       j.AuthorID,
+			Mode:           j.Mode,
+			State:          j.State,
+			BlogsProcessed: j.BlogsProcessed,
+			AuthorRemoved:  j.AuthorRemoved,
+			Error:          j.Error,
+			CreatedAt:      j.CreatedAt,
+			UpdatedAt:      j.UpdatedAt,
+			CompletedAt:    j.CompletedAt,
+			Unfinished:     Unfinished(j.State),
+		})
+	}
+	return insertMany(ctx, s.jobs, docs)
+}
+
+func insertMany(ctx context.Context, collection *mongo.Collection, docs []interface{}) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	_, err := collection.InsertMany(ctx, docs)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrNotEmpty
+	}
+	return classify(err)
+}
+
+// classify marks the network errors and server timeouts as ErrUnavailable,
+// keeping the driver error as the cause. Context errors are left alone.
+func classify(err error) error {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	if mongo.IsNetworkError(err) || mongo.IsTimeout(err) {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	return err
+}
+
+// Ping checks the primary can be reached.
+func (s *MongoStore) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx, readpref.Primary())
+}
+
+// Close disconnects the underlying Mongo client.
+func (s *MongoStore) Close(ctx context.Context) error {
+	return s.client.Disconnect(ctx)
+}
+
+// mongoSnapshot reads through a snapshot session.
+type mongoSnapshot struct {
+	*MongoStore
+	sess mongo.Session
+}
+
+// Snapshot returns a view reading every collection at the time of its
+// first read. Snapshot reads need MongoDB 5.0 or later on a replica set.
+func (s *MongoStore) Snapshot(ctx context.Context) (Store, error) {
+	sess, err := s.client.StartSession(options.Session().SetSnapshot(true))
+	if err != nil {
+		return nil, err
+	}
+	return &mongoSnapshot{MongoStore: s, sess: sess}, nil
+}
+
+func (s *mongoSnapshot) ReadBlog(ctx context.Context, id string) (*Blog, error) {
+	return s.MongoStore.ReadBlog(mongo.NewSessionContext(ctx, s.sess), id)
+}
+
+func (s *mongoSnapshot) ListBlogs(ctx context.Context, filter Filter, fn func(*Blog) error) error {
+	return s.MongoStore.ListBlogs(mongo.NewSessionContext(ctx, s.sess), filter, fn)
+}
+
+func (s *mongoSnapshot) LastBlogDeletion(ctx context.Context) (time.Time, error) {
+	return s.MongoStore.LastBlogDeletion(mongo.NewSessionContext(ctx, s.sess))
+}
+
+func (s *mongoSnapshot) ReadAuthor(ctx context.Context, id string) (*Author, error) {
+	return s.MongoStore.ReadAuthor(mongo.NewSessionContext(ctx, s.sess), id)
+}
+
+func (s *mongoSnapshot) ListAuthors(ctx context.Context, fn func(*Author) error) error {
+	return s.MongoStore.ListAuthors(mongo.NewSessionContext(ctx, s.sess), fn)
+}
+
+func (s *mongoSnapshot) ReadErasureJob(ctx context.Context, id string) (*ErasureJob, error) {
+	return s.MongoStore.ReadErasureJob(mongo.NewSessionContext(ctx, s.sess), id)
+}
+
+func (s *mongoSnapshot) ListErasureJobs(ctx context.Context, fn func(*ErasureJob) error) error {
+	return s.MongoStore.ListErasureJobs(mongo.NewSessionContext(ctx, s.sess), fn)
+}
+
+// Close ends the session, leaving the client of the store connected.
+func (s *mongoSnapshot) Close(ctx context.Context) error {
+	s.sess.EndSession(ctx)
+	return nil
+}
+
+// stagingSuffix names the collections a restore imports into.
+const stagingSuffix = "_restore"
+
+// mongoStaging imports into the staging collections, renamed over those of
+// the store on Commit.
+type mongoStaging struct {
+	*MongoStore
+	into *MongoStore
+}
+
+// Stage drops the staging collections left by an earlier restore and
+// creates them afresh.
+func (s *MongoStore) Stage(ctx context.Context) (Staging, error) {
+	db := s.collection.Database()
+	st := &mongoStaging{
+		MongoStore: &MongoStore{
+			client:     s.client,
+			collection: db.Collection(s.collection.Name() + stagingSuffix),
+			authors:    db.Collection(s.authors.Name() + stagingSuffix),
+			jobs:       db.Collection(s.jobs.Name() + stagingSuffix),
+		},
+		into: s,
+	}
+	if err := st.Abort(ctx); err != nil {
+		return nil, err
+	}
+	for _, c := range st.collections() {
+		if err := db.CreateCollection(ctx, c.Name()); err != nil {
+			return nil, classify(err)
+		}
+	}
+	if err := createJobIndex(ctx, st.jobs); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+func (s *MongoStore) collections() []*mongo.Collection {
+	return []*mongo.Collection{s.authors, s.collection, s.jobs}
+}
+
+// Commit renames each staging collection over the matching one of the
+// store. Neither step is atomic: a write made between the emptiness check
+// and the renames is dropped with the collection it landed in, and a
+// failure part way leaves the remaining collections staged. Restore with
+// the servers stopped.
+func (st *mongoStaging) Commit(ctx context.Context) error {
+	targets := st.into.collections()
+	for _, c := range targets {
+		n, err := c.CountDocuments(ctx, bson.M{})
+		if err != nil {
+			return classify(err)
+		}
+		if n > 0 {
+			return ErrNotEmpty
+		}
+	}
+	admin := st.client.Database("admin")
+	for i, c := range st.collections() {
+		db := c.Database().Name()
+		err := admin.RunCommand(ctx, bson.D{
+			{Key: "renameCollection", Value: db + "." + c.Name()},
+			{Key: "to", Value: db + "." + targets[i].Name()},
+			{Key: "dropTarget", Value: true},
+		}).Err()
+		if err != nil {
+			return classify(err)
+		}
+	}
+	return nil
+}
+
+func (st *mongoStaging) Abort(ctx context.Context) error {
+	for _, c := range st.collections() {
+		if err := c.Drop(ctx); err != nil {
+			return classify(err)
+		}
+	}
+	return nil
+}
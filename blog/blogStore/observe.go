@@ -0,0 +1,50 @@
+package blogstore
+
+import "context"
+
+// Observer is notified after every successful write to an observed store.
+type Observer interface {
+	BlogChanged(b *Blog)
+	BlogDeleted(id string)
+}
+
+type observedStore struct {
+	Store
+	observers []Observer
+}
+
+// Observe wraps s so that observers learn about every blog written through
+// the returned store. Writes made to s directly are not reported.
+func Observe(s Store, observers ...Observer) Store {
+	return &observedStore{Store: s, observers: observers}
+}
+
+func (s *observedStore) CreateBlog(ctx context.Context, b *Blog) (*Blog, error) {
+	res, err := s.Store.CreateBlog(ctx, b)
+	if err == nil {
+		for _, o := range s.observers {
+			o.BlogChanged(copyBlog(res))
+		}
+	}
+	return res, err
+}
+
+func (s *observedStore) UpdateBlog(ctx context.Context, b *Blog) (*Blog, error) {
+	res, err := s.Store.UpdateBlog(ctx, b)
+	if err == nil {
+		for _, o := range s.observers {
+			o.BlogChanged(copyBlog(res))
+		}
+	}
+	return res, err
+}
+
+func (s *observedStore) DeleteBlog(ctx context.Context, id string) error {
+	err := s.Store.DeleteBlog(ctx, id)
+	if err == nil {
+		for _, o := range s.observers {
+			o.BlogDeleted(id)
+		}
+	}
+	return err
+}
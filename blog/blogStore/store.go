@@ -0,0 +1,219 @@
+// Package blogstore holds the persistence layer behind the blog service.
+// Every consumer of blog data (the gRPC handlers, the HTTP feeds) goes
+// through the Store interface so the backends stay interchangeable.
+package blogstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when the requested blog does not exist.
+	ErrNotFound = errors.New("blog not found")
+	// ErrInvalidID is returned when an id cannot be parsed by the backend.
+	ErrInvalidID = errors.New("invalid blog id")
+	// ErrAuthorNotFound is returned when the requested author does not exist.
+	ErrAuthorNotFound = errors.New("author not found")
+	// ErrAuthorExists is returned when creating an author with a taken id.
+	ErrAuthorExists = errors.New("author already exists")
+	// ErrJobNotFound is returned when the requested erasure job does not exist.
+	ErrJobNotFound = errors.New("erasure job not found")
+	// ErrJobExists is returned with the unfinished job of an author when
+	// creating another one for the same author.
+	ErrJobExists = errors.New("author already has an unfinished erasure job")
+	// ErrNotEmpty is returned when importing a record whose id is already taken.
+	ErrNotEmpty = errors.New("store already holds a record with this id")
+	// ErrUnavailable is returned when the backend cannot be reached for now;
+	// the call may succeed if retried.
+	ErrUnavailable = errors.New("store unavailable")
+)
+
+// Blog is the stored representation of a blog post.
+type Blog struct {
+	ID        string
+	AuthorID  string
+	Title     string
+	Content   string
+	Tags      []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Author is the stored profile of a blog author.
+type Author struct {
+	ID          string
+	DisplayName string
+	Bio         string
+	AvatarURL   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Erasure modes and states, mirroring the blogpb enums.
+const (
+	ErasureDelete    = "delete"
+	ErasureAnonymise = "anonymise"
+
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+)
+
+// Unfinished tells whether a job in state still has work to do.
+func Unfinished(state string) bool {
+	return state == JobPending || state == JobRunning
+}
+
+// ErasureJob records the progress of erasing one author's data. It is
+// persisted so that an interrupted job can be resumed.
+type ErasureJob struct {
+	ID             string
+	AuthorID       string
+	Mode           string
+	State          string
+	BlogsProcessed int64
+	AuthorRemoved  bool
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CompletedAt    time.Time
+}
+
+// Filter restricts the blogs returned by ListBlogs. Empty fields match everything.
+type Filter struct {
+	AuthorID string
+	Tag      string
+	// Recent lists the most recently updated blogs first instead of in
+	// creation order.
+	Recent bool
+	// Limit caps the number of blogs listed when positive.
+	Limit int
+}
+
+// Match reports whether b satisfies the filter.
+func (f Filter) Match(b *Blog) bool {
+	if f.AuthorID != "" && b.AuthorID != f.AuthorID {
+		return false
+	}
+	if f.Tag == "" {
+		return true
+	}
+	for _, t := range b.Tags {
+		if t == f.Tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Store is implemented by every blog backend.
+type Store interface {
+	// CreateBlog stores b, assigning its ID and timestamps.
+	CreateBlog(ctx context.Context, b *Blog) (*Blog, error)
+	ReadBlog(ctx context.Context, id string) (*Blog, error)
+	// UpdateBlog replaces the blog with the same ID, keeping CreatedAt.
+	UpdateBlog(ctx context.Context, b *Blog) (*Blog, error)
+	DeleteBlog(ctx context.Context, id string) error
+	// ListBlogs calls fn for every blog matching filter until fn returns an error.
+	ListBlogs(ctx context.Context, filter Filter, fn func(*Blog) error) error
+	// LastBlogDeletion returns when a blog was last deleted, the zero time
+	// if none was.
+	LastBlogDeletion(ctx context.Context) (time.Time, error)
+	AuthorStore
+	JobStore
+	// Ping checks the store can be reached.
+	Ping(ctx context.Context) error
+	Close(ctx context.Context) error
+}
+
+// AuthorStore holds author profiles. Author ids are free-form strings so
+// that the ids already referenced by existing blogs can be registered.
+type AuthorStore interface {
+	// CreateAuthor stores a, generating an ID when it is empty.
+	CreateAuthor(ctx context.Context, a *Author) (*Author, error)
+	ReadAuthor(ctx context.Context, id string) (*Author, error)
+	// UpdateAuthor replaces the author with the same ID, keeping CreatedAt.
+	UpdateAuthor(ctx context.Context, a *Author) (*Author, error)
+	DeleteAuthor(ctx context.Context, id string) error
+	ListAuthors(ctx context.Context, fn func(*Author) error) error
+}
+
+// JobStore persists erasure jobs.
+type JobStore interface {
+	// CreateErasureJob stores j, assigning its ID and timestamps. An
+	// author has at most one unfinished job: if j is unfinished and the
+	// author has one already, that job is returned with ErrJobExists.
+	CreateErasureJob(ctx context.Context, j *ErasureJob) (*ErasureJob, error)
+	ReadErasureJob(ctx context.Context, id string) (*ErasureJob, error)
+	// UpdateErasureJob replaces the job with the same ID, refreshing UpdatedAt.
+	UpdateErasureJob(ctx context.Context, j *ErasureJob) (*ErasureJob, error)
+	ListErasureJobs(ctx context.Context, fn func(*ErasureJob) error) error
+}
+
+// Importer loads records as they are, keeping their ids and timestamps.
+// It is used to restore backups and is implemented by every backend.
+type Importer interface {
+	ImportBlogs(ctx context.Context, blogs []*Blog) error
+	ImportAuthors(ctx context.Context, authors []*Author) error
+	ImportErasureJobs(ctx context.Context, jobs []*ErasureJob) error
+}
+
+// Snapshotter is implemented by stores that can be read at a single point
+// in time.
+type Snapshotter interface {
+	// Snapshot returns a read-only view of the store as it is now, to
+	// release with Close. Writing through it is not supported.
+	Snapshot(ctx context.Context) (Store, error)
+}
+
+// Stager is implemented by stores restoring backups aside, so that a
+// failed restore leaves the store untouched.
+type Stager interface {
+	Stage(ctx context.Context) (Staging, error)
+}
+
+// Staging holds imported records until they are committed to the store.
+type Staging interface {
+	Importer
+	// Commit moves the records into the store, which must still be
+	// empty, or returns ErrNotEmpty.
+	Commit(ctx context.Context) error
+	// Abort drops the records.
+	Abort(ctx context.Context) error
+}
+
+// Config selects and configures a backend.
+type Config struct {
+	// Backend is one of "mongo", "memory" or "file".
+	Backend string      `config:"store" usage:"blog store backend: mongo, memory or file"`
+	Mongo   MongoConfig `config:"mongo"`
+	// Path is the JSON file used by the file backend.
+	Path string `config:"store-path" usage:"JSON file used by the file store"`
+}
+
+// Open returns the store described by cfg.
+func Open(ctx context.Context, cfg Config) (Store, error) {
+	switch cfg.Backend {
+	case "mongo":
+		return NewMongoStore(ctx, cfg.Mongo)
+	case "memory":
+		return NewMemoryStore(), nil
+	case "file":
+		if cfg.Path == "" {
+			return nil, errors.New("file store needs a path")
+		}
+		return OpenFileStore(cfg.Path)
+	default:
+		return nil, fmt.Errorf("unknown store %q", cfg.Backend)
+	}
+}
+
+// now is truncated to milliseconds, the precision Mongo stores dates with,
+// so both backends hand out identical timestamps.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
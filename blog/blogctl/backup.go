@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	blogbackup "github.com/dipjyotimetia/gogrpc/blog/blogBackup"
+	blogservice "github.com/dipjyotimetia/gogrpc/blog/blogService"
+	blogstore "github.com/dipjyotimetia/gogrpc/blog/blogStore"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+)
+
+// storeSettings select the store to back up or restore, from the config
+// file, BLOGCTL_* variables and flags. They default to those of the blog
+// server, but for the MongoDB password.
+type storeSettings struct {
+	Store blogstore.Config `config:",inline"`
+}
+
+func (c *storeSettings) Check(p *config.Problems) {
+	p.OneOf("store", c.Store.Backend, "mongo", "file")
+	if c.Store.Backend == "mongo" && c.Store.Mongo.Username != "" && c.Store.Mongo.Password == "" {
+		p.Add("mongo-password", "a password is required for user %q", c.Store.Mongo.Username)
+	}
+}
+
+// loadStore registers the store settings on fs and parses args.
+func loadStore(fs *flag.FlagSet, args []string) (*blogstore.Config, error) {
+	cfg := storeSettings{Store: blogservice.DefaultSettings().Store}
+	cfg.Store.Mongo.Password = ""
+	if _, err := config.LoadFlagSet(fs, args, "blogctl", &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg.Store, nil
+}
+
+func openStore(cfg *blogstore.Config) (blogstore.Store, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return blogstore.Open(ctx, *cfg)
+}
+
+func printManifest(m *blogbackup.Manifest) {
+	fmt.Printf("schema version %d, created %s from %s\n", m.SchemaVersion, m.CreatedAt.Format(time.RFC3339), m.Source)
+	for _, c := range m.Collections {
+		fmt.Printf("  %-14s %6d records  sha256 %s\n", c.Name, c.Count, c.SHA256)
+	}
+}
+
+var backupCommand = &command{
+	usage: "[-o FILE] [-consistent=false] [store flags]",
+	summary: "write a compressed, checksummed backup archive of the blog store\n\n" +
+		"The backup reads every collection at a single point in time, which needs\n" +
+		"MongoDB 5.0 or later on a replica set. With -consistent=false, for older\n" +
+		"servers, writes landing during the backup may or may not be included.",
+	run: func(fs *flag.FlagSet, args []string) error {
+		out := fs.String("o", "", "archive to write (default blog-backup-TIMESTAMP.tar.gz)")
+		consistent := fs.Bool("consistent", true, "read the store at a single point in time")
+		cfg, err := loadStore(fs, args)
+		if err != nil {
+			return err
+		}
+		if fs.NArg() != 0 {
+			return errUsage
+		}
+		if *out == "" {
+			*out = "blog-backup-" + time.Now().UTC().Format("20060102T150405Z") + ".tar.gz"
+		}
+
+		store, err := openStore(cfg)
+		if err != nil {
+			return err
+		}
+		defer store.Close(context.Background())
+		if *consistent {
+			snap, err := store.(blogstore.Snapshotter).Snapshot(context.Background())
+			if err != nil {
+				return err
+			}
+			defer snap.Close(context.Background())
+			store = snap
+		}
+
+		// write next to the target and rename, so a failed backup never
+		// leaves a truncated archive behind
+		tmp, err := os.CreateTemp(filepath.Dir(*out), filepath.Base(*out)+".*.tmp")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmp.Name())
+		m, err := blogbackup.Write(context.Background(), store, cfg.Backend, tmp)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		if err := tmp.Close(); err != nil {
+			return err
+		}
+		if err := os.Rename(tmp.Name(), *out); err != nil {
+			return err
+		}
+		fmt.Printf("wrote %s\n", *out)
+		printManifest(m)
+		return nil
+	},
+}
+
+var restoreCommand = &command{
+	usage: "[store flags] ARCHIVE",
+	summary: "restore a backup archive into an empty blog store\n\n" +
+		"Records are imported aside, into *_restore collections with MongoDB, and\n" +
+		"only moved into the store once all of them are, so a failed import\n" +
+		"leaves the store untouched.\n\n" +
+		"Stop the blog servers first. The store is checked empty, then its\n" +
+		"collections are replaced one by one: a blog written meanwhile is lost,\n" +
+		"and a failure between two renames leaves the rest in *_restore.",
+	run: func(fs *flag.FlagSet, args []string) error {
+		cfg, err := loadStore(fs, args)
+		if err != nil {
+			return err
+		}
+		if fs.NArg() != 1 {
+			return errUsage
+		}
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		store, err := openStore(cfg)
+		if err != nil {
+			return err
+		}
+		defer store.Close(context.Background())
+
+		m, err := blogbackup.Restore(context.Background(), store, f)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("restored %s into the %s store\n", fs.Arg(0), cfg.Backend)
+		printManifest(m)
+		return nil
+	},
+}
+
+var verifyCommand = &command{
+	usage:   "ARCHIVE",
+	summary: "check the manifest and checksums of a backup archive",
+	run: func(fs *flag.FlagSet, args []string) error {
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() != 1 {
+			return errUsage
+		}
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		m, err := blogbackup.Verify(f)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s is a valid backup\n", fs.Arg(0))
+		printManifest(m)
+		return nil
+	},
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+)
+
+// tagsFlag collects -tag values, each of which may be a comma separated list.
+type tagsFlag []string
+
+func (t *tagsFlag) String() string { return strings.Join(*t, ",") }
+
+func (t *tagsFlag) Set(v string) error {
+	for _, tag := range strings.Split(v, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			*t = append(*t, tag)
+		}
+	}
+	return nil
+}
+
+// blogFlags are the editable fields of a blog.
+type blogFlags struct {
+	author      string
+	title       string
+	tags        tagsFlag
+	content     string
+	contentFile string
+}
+
+func registerBlogFlags(fs *flag.FlagSet) *blogFlags {
+	b := &blogFlags{}
+	fs.StringVar(&b.author, "author", "", "author id of the blog")
+	fs.StringVar(&b.title, "title", "", "title of the blog")
+	fs.Var(&b.tags, "tag", "tag of the blog, repeat or separate with commas")
+	fs.StringVar(&b.content, "content", "", "content of the blog")
+	fs.StringVar(&b.contentFile, "content-file", "", "read the content from a file, - for stdin")
+	return b
+}
+
+// apply copies the flags given on the command line onto blog.
+func (b *blogFlags) apply(fs *flag.FlagSet, blog *blogpb.Blog) error {
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if set["content"] && set["content-file"] {
+		return errors.New("-content and -content-file are mutually exclusive")
+	}
+	if set["author"] {
+		blog.AuthorId = b.author
+	}
+	if set["title"] {
+		blog.Title = b.title
+	}
+	if set["tag"] {
+		blog.Tags = b.tags
+	}
+	if set["content"] {
+		blog.Content = b.content
+	}
+	if set["content-file"] {
+		content, err := readContent(b.contentFile)
+		if err != nil {
+			return err
+		}
+		blog.Content = content
+	}
+	return nil
+}
+
+func readContent(path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading content: %w", err)
+	}
+	return string(data), nil
+}
+
+// parseInterspersed parses fs allowing flags after the positional
+// arguments, so that "blogctl read ID -o json" works as expected.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// clientCommand wires the flags shared by every RPC command and runs fn
+// with a connected client.
+func clientCommand(fs *flag.FlagSet, args []string, nargs int, register func(), fn func(ctx context.Context, c blogpb.BlogServiceClient, format string, args []string) error) error {
+	conn := registerConnFlags(fs)
+	format := fs.String("o", "table", "output format: table, json or yaml")
+	if register != nil {
+		register()
+	}
+	args, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != nargs {
+		return errUsage
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), conn.timeout)
+	defer cancel()
+	cc, err := conn.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	return fn(ctx, blogpb.NewBlogServiceClient(cc), *format, args)
+}
+
+var createCommand = &command{
+	usage:   "-author ID -title TITLE [-tag TAG]... [-content TEXT | -content-file FILE|-] [flags]",
+	summary: "create a blog",
+	run: func(fs *flag.FlagSet, args []string) error {
+		var b *blogFlags
+		return clientCommand(fs, args, 0, func() { b = registerBlogFlags(fs) }, func(ctx context.Context, c blogpb.BlogServiceClient, format string, args []string) error {
+			blog := &blogpb.Blog{}
+			if err := b.apply(fs, blog); err != nil {
+				return err
+			}
+			res, err := c.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: blog})
+			if err != nil {
+				return err
+			}
+			return printBlog(os.Stdout, format, res.GetBlog(), nil)
+		})
+	},
+}
+
+var readCommand = &command{
+	usage:   "[-with-author] [flags] BLOG_ID",
+	summary: "show a blog",
+	run: func(fs *flag.FlagSet, args []string) error {
+		var withAuthor *bool
+		return clientCommand(fs, args, 1, func() {
+			withAuthor = fs.Bool("with-author", false, "include the author profile")
+		}, func(ctx context.Context, c blogpb.BlogServiceClient, format string, args []string) error {
+			res, err := c.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: args[0], IncludeAuthor: *withAuthor})
+			if err != nil {
+				return err
+			}
+			return printBlog(os.Stdout, format, res.GetBlog(), res.GetAuthor())
+		})
+	},
+}
+
+var updateCommand = &command{
+	usage:   "[-author ID] [-title TITLE] [-tag TAG]... [-content TEXT | -content-file FILE|-] [flags] BLOG_ID",
+	summary: "change the fields of a blog given on the command line",
+	run: func(fs *flag.FlagSet, args []string) error {
+		var b *blogFlags
+		return clientCommand(fs, args, 1, func() { b = registerBlogFlags(fs) }, func(ctx context.Context, c blogpb.BlogServiceClient, format string, args []string) error {
+			// UpdateBlog replaces the whole blog, start from the current one
+			current, err := c.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: args[0]})
+			if err != nil {
+				return err
+			}
+			blog := current.GetBlog()
+			if err := b.apply(fs, blog); err != nil {
+				return err
+			}
+			res, err := c.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{Blog: blog})
+			if err != nil {
+				return err
+			}
+			return printBlog(os.Stdout, format, res.GetBlog(), nil)
+		})
+	},
+}
+
+var deleteCommand = &command{
+	usage:   "[flags] BLOG_ID",
+	summary: "delete a blog",
+	run: func(fs *flag.FlagSet, args []string) error {
+		return clientCommand(fs, args, 1, nil, func(ctx context.Context, c blogpb.BlogServiceClient, format string, args []string) error {
+			res, err := c.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: args[0]})
+			if err != nil {
+				return err
+			}
+			if format == "table" {
+				fmt.Printf("deleted %s\n", res.GetBlogId())
+				return nil
+			}
+			v, err := toPlain(res)
+			if err != nil {
+				return err
+			}
+			return encode(os.Stdout, format, v)
+		})
+	},
+}
+
+var listCommand = &command{
+	usage:   "[-author ID] [-tag TAG] [flags]",
+	summary: "list blogs as the server streams them",
+	run: func(fs *flag.FlagSet, args []string) error {
+		var author, tag *string
+		return clientCommand(fs, args, 0, func() {
+			author = fs.String("author", "", "only list blogs of this author")
+			tag = fs.String("tag", "", "only list blogs carrying this tag")
+		}, func(ctx context.Context, c blogpb.BlogServiceClient, format string, args []string) error {
+			stream, err := c.ListBlog(ctx, &blogpb.ListBlogRequest{AuthorId: *author, Tag: *tag})
+			if err != nil {
+				return err
+			}
+			p := newBlogPrinter(os.Stdout, format)
+			for {
+				res, err := stream.Recv()
+				if err == io.EOF {
+					return p.close()
+				}
+				if err != nil {
+					p.close()
+					return err
+				}
+				if err := p.add(res.GetBlog()); err != nil {
+					return err
+				}
+			}
+		})
+	},
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/deadline"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// connFlags are shared by every command talking to the blog server.
+type connFlags struct {
+	target     string
+	tls        bool
+	caCert     string
+	cert       string
+	key        string
+	serverName string
+	timeout    time.Duration
+	margin     time.Duration
+}
+
+func registerConnFlags(fs *flag.FlagSet) *connFlags {
+	c := &connFlags{}
+	fs.StringVar(&c.target, "target", "localhost:50053", "address of the blog server")
+	fs.BoolVar(&c.tls, "tls", false, "connect with TLS")
+	fs.StringVar(&c.caCert, "ca-cert", "ssl/ca.crt", "CA certificate used to verify the server with -tls")
+	fs.StringVar(&c.cert, "cert", "", "PEM client certificate presented to servers requiring mutual TLS")
+	fs.StringVar(&c.key, "key", "", "PEM key of -cert")
+	fs.StringVar(&c.serverName, "server-name", "", "override the server name checked against the certificate")
+	fs.DurationVar(&c.timeout, "timeout", 10*time.Second, "deadline of the whole command")
+	fs.DurationVar(&c.margin, "deadline-margin", 100*time.Millisecond, "time kept from the deadline of each call to report its failure")
+	return c
+}
+
+func (c *connFlags) dial(ctx context.Context) (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithChainUnaryInterceptor(deadline.UnaryClientInterceptor(c.margin)),
+		grpc.WithChainStreamInterceptor(deadline.StreamClientInterceptor(c.margin)),
+	}
+	if c.tls {
+		cfg, err := mtls.ClientConfig(c.caCert, c.cert, c.key, c.serverName)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(cfg)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	cc, err := grpc.DialContext(ctx, c.target, opts...)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("cannot reach %s: %w", c.target, err)
+	}
+	return cc, err
+}
+
+// exitCode maps an error to the process exit status. RPC failures exit with
+// 10 plus their gRPC status code, e.g. 15 for NOT_FOUND and 24 for
+// UNAVAILABLE, so scripts can tell them apart from usage (2) and local (1)
+// errors.
+func exitCode(err error) int {
+	if errors.Is(err, context.DeadlineExceeded) {
+		// the dial timed out before any RPC produced a status
+		return 10 + 4
+	}
+	if st, ok := status.FromError(err); ok {
+		return 10 + int(st.Code())
+	}
+	return 1
+}
@@ -0,0 +1,97 @@
+// Command blogctl talks to and administers the blog service.
+//
+//	blogctl create  [flags]            create a blog
+//	blogctl read    [flags] BLOG_ID    show a blog
+//	blogctl update  [flags] BLOG_ID    change fields of a blog
+//	blogctl delete  [flags] BLOG_ID    delete a blog
+//	blogctl list    [flags]            list blogs
+//	blogctl backup  [flags]            write a backup archive of the blog store
+//	blogctl restore [flags] ARCHIVE    restore an archive into an empty store
+//	blogctl verify  ARCHIVE            check an archive without restoring it
+//
+// The RPC commands take -target and the TLS flags, and print a table, JSON
+// or YAML with -o. Exit status is 0 on success, 1 on local errors, 2 on
+// usage errors and 10 plus the gRPC status code when an RPC fails.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"google.golang.org/grpc/status"
+)
+
+// errUsage makes main print the command usage and exit with status 2.
+var errUsage = errors.New("usage")
+
+type command struct {
+	usage   string
+	summary string
+	run     func(fs *flag.FlagSet, args []string) error
+}
+
+var commands = map[string]*command{
+	"create":  createCommand,
+	"read":    readCommand,
+	"update":  updateCommand,
+	"delete":  deleteCommand,
+	"list":    listCommand,
+	"backup":  backupCommand,
+	"restore": restoreCommand,
+	"verify":  verifyCommand,
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: blogctl COMMAND [flags]")
+	fmt.Fprintln(os.Stderr)
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Run 'blogctl COMMAND -h' for the flags of a command.")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	name := os.Args[1]
+	if name == "-h" || name == "--help" || name == "help" {
+		usage()
+		return
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "blogctl: unknown command %q\n", name)
+		usage()
+		os.Exit(2)
+	}
+
+	fs := flag.NewFlagSet("blogctl "+name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: blogctl %s %s\n\n%s\n\n", name, cmd.usage, cmd.summary)
+		fs.PrintDefaults()
+	}
+	err := cmd.run(fs, os.Args[2:])
+	if errors.Is(err, errUsage) {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			fmt.Fprintf(os.Stderr, "blogctl %s: %s: %s\n", name, st.Code(), st.Message())
+		} else {
+			fmt.Fprintf(os.Stderr, "blogctl %s: %v\n", name, err)
+		}
+		os.Exit(exitCode(err))
+	}
+}
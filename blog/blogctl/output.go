@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v2"
+)
+
+var jsonOptions = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+func checkFormat(format string) error {
+	switch format {
+	case "table", "json", "yaml":
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q, want table, json or yaml", format)
+}
+
+// toPlain turns a message into maps and slices so it can go through the
+// JSON and YAML encoders with proto field names.
+func toPlain(m proto.Message) (interface{}, error) {
+	data, err := jsonOptions.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	err = json.Unmarshal(data, &v)
+	return v, err
+}
+
+func encode(w io.Writer, format string, v interface{}) error {
+	if format == "yaml" {
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func formatTime(ts interface{ AsTime() time.Time }) string {
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
+
+// printBlog writes a single blog, with its author when present.
+func printBlog(w io.Writer, format string, blog *blogpb.Blog, author *blogpb.Author) error {
+	if format != "table" {
+		v, err := toPlain(blog)
+		if err != nil {
+			return err
+		}
+		if author != nil {
+			a, err := toPlain(author)
+			if err != nil {
+				return err
+			}
+			v = map[string]interface{}{"blog": v, "author": a}
+		}
+		return encode(w, format, v)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID:\t%s\n", blog.GetId())
+	fmt.Fprintf(tw, "AUTHOR:\t%s\n", blog.GetAuthorId())
+	if author != nil {
+		fmt.Fprintf(tw, "AUTHOR NAME:\t%s\n", author.GetDisplayName())
+	}
+	fmt.Fprintf(tw, "TITLE:\t%s\n", blog.GetTitle())
+	fmt.Fprintf(tw, "TAGS:\t%s\n", strings.Join(blog.GetTags(), ","))
+	fmt.Fprintf(tw, "CREATED:\t%s\n", formatTime(blog.GetCreatedAt()))
+	fmt.Fprintf(tw, "UPDATED:\t%s\n", formatTime(blog.GetUpdatedAt()))
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "\n%s\n", blog.GetContent())
+	return err
+}
+
+// blogPrinter writes the blogs of a list as they arrive. JSON output is a
+// single array, YAML a sequence and table one row per blog.
+type blogPrinter struct {
+	w      io.Writer
+	format string
+	tw     *tabwriter.Writer
+	count  int
+}
+
+func newBlogPrinter(w io.Writer, format string) *blogPrinter {
+	p := &blogPrinter{w: w, format: format}
+	if format == "table" {
+		p.tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(p.tw, "ID\tAUTHOR\tTITLE\tTAGS\tUPDATED")
+	}
+	return p
+}
+
+func (p *blogPrinter) add(blog *blogpb.Blog) error {
+	defer func() { p.count++ }()
+	switch p.format {
+	case "table":
+		_, err := fmt.Fprintf(p.tw, "%s\t%s\t%s\t%s\t%s\n", blog.GetId(), blog.GetAuthorId(),
+			blog.GetTitle(), strings.Join(blog.GetTags(), ","), formatTime(blog.GetUpdatedAt()))
+		return err
+	case "yaml":
+		v, err := toPlain(blog)
+		if err != nil {
+			return err
+		}
+		data, err := yaml.Marshal([]interface{}{v})
+		if err != nil {
+			return err
+		}
+		_, err = p.w.Write(data)
+		return err
+	default:
+		data, err := jsonOptions.Marshal(blog)
+		if err != nil {
+			return err
+		}
+		sep := ",\n"
+		if p.count == 0 {
+			sep = "[\n"
+		}
+		_, err = fmt.Fprintf(p.w, "%s%s", sep, data)
+		return err
+	}
+}
+
+func (p *blogPrinter) close() error {
+	switch p.format {
+	case "table":
+		return p.tw.Flush()
+	case "yaml":
+		if p.count == 0 {
+			_, err := fmt.Fprintln(p.w, "[]")
+			return err
+		}
+		return nil
+	default:
+		if p.count == 0 {
+			_, err := fmt.Fprintln(p.w, "[]")
+			return err
+		}
+		_, err := fmt.Fprintln(p.w, "\n]")
+		return err
+	}
+}
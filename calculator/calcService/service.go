@@ -0,0 +1,50 @@
+// Package calcservice implements the calc.SumService.
+package calcservice
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/dipjyotimetia/gogrpc/calculator/calcpb"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/grpc/codes"
+)
+
+// ServiceName is the name of the service in health checks.
+const ServiceName = "calc.SumService"
+
+// Server implements calcpb.SumServiceServer.
+type Server struct{}
+
+// New returns the calc service.
+func New() *Server {
+	return &Server{}
+}
+
+var errSumOverflow = errors.New("sum does not fit in an int32")
+
+func init() {
+	rpcerr.Register(errSumOverflow, rpcerr.Mapping{Code: codes.OutOfRange, Reason: "SUM_OVERFLOW"})
+}
+
+func (*Server) Sum(ctx context.Context, req *calcpb.SumRequest) (*calcpb.SumResponse, error) {
+	firstArgument := req.GetFirstNumber()
+	secondArgument := req.GetSecondNumber()
+
+	result := int64(firstArgument) + int64(secondArgument)
+	if result > math.MaxInt32 || result < math.MinInt32 {
+		return nil, rpcerr.Convert(errSumOverflow)
+	}
+	res := &calcpb.SumResponse{
+		Result: int32(result),
+	}
+	return res, nil
+}
+
+func (*Server) SquareRoot(ctx context.Context, req *calcpb.SquareRootRequest) (*calcpb.SquareRootResponse, error) {
+	number := req.GetNumber()
+	return &calcpb.SquareRootResponse{
+		NumberRoot: math.Sqrt(float64(number)),
+	}, nil
+}
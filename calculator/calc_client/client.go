@@ -4,18 +4,57 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/dipjyotimetia/gogrpc/calculator/calcpb"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/metrics"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// settings of the client, from the config file, CALC_CLIENT_* variables and flags.
+type settings struct {
+	Target  string           `config:"target" usage:"address of the calc server"`
+	TLS     config.ClientTLS `config:",inline"`
+	Tracing config.Tracing   `config:",inline"`
+
+	PrintMetrics bool `config:"print-metrics" usage:"print the client metrics of the calls, in the Prometheus text format, when done"`
+}
+
+func (c *settings) Check(p *config.Problems) {
+	p.Addr("target", c.Target, false)
+	c.TLS.Check(p)
+	c.Tracing.Check(p)
+}
+
 func main() {
 
 	fmt.Println("Hello i am a calc client")
 
-	cc, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
+	cfg := settings{Target: "localhost:50052", TLS: config.ClientTLS{CACert: "ssl/ca.crt"}, Tracing: config.DefaultTracing()}
+	if _, err := config.Load("calc_client", &cfg); err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	opts, err := cfg.TLS.DialOption()
+	if err != nil {
+		log.Fatalf("Error while loading certificates %v", err)
+	}
+
+	registry := metrics.NewRegistry()
+	rpcMetrics := metrics.NewClientRPC(registry)
+	tracer, err := cfg.Tracing.Tracer("calc_client", logging.Default())
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	cc, err := grpc.Dial(cfg.Target, opts,
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor(tracer), rpcMetrics.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor(tracer), rpcMetrics.StreamClientInterceptor()),
+	)
 
 	if err != nil {
 		log.Fatalf("error is %v", err)
@@ -27,6 +66,13 @@ func main() {
 	// doUnary(c)
 	doErrorUnary(c, 10)
 	doErrorUnary(c, -2)
+
+	if err := tracer.Shutdown(context.Background()); err != nil {
+		fmt.Printf("Failed exporting the traces: %v\n", err)
+	}
+	if cfg.PrintMetrics {
+		registry.WriteText(os.Stdout)
+	}
 }
 
 func doUnary(c calcpb.SumServiceClient) {
@@ -51,7 +97,7 @@ func doErrorUnary(c calcpb.SumServiceClient, number int32) {
 		if ok {
 			fmt.Println(resErr.Message())
 			fmt.Println(resErr.Code())
-			if resErr.Code() == codes.InvalidArgument {
+			if rpcerr.Reason(err) == rpcerr.ReasonInvalidArgument {
 				fmt.Println("We probably sent a negative error")
 			}
 		} else {
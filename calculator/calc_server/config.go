@@ -0,0 +1,53 @@
+package main
+
+import (
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+)
+
+// settings of the calc server, from the config file, CALC_* variables and
+// flags.
+type settings struct {
+	Addr        string `config:"addr" usage:"gRPC and gRPC-Web listen address"`
+	HTTPAddr    string `config:"http-addr" usage:"HTTP/JSON gateway listen address, empty to disable"`
+	MetricsAddr string `config:"metrics-addr" usage:"listen address of the Prometheus /metrics endpoint and the health probes, empty to disable"`
+	AdminAddr   string `config:"admin-addr" usage:"listen address of /debug/logging, changing the logging at runtime, empty to disable; keep it on loopback"`
+	WebOrigins  string `config:"grpc-web-origins" usage:"comma separated origins allowed to call over gRPC-Web from other sites, * for any, none when empty"`
+
+	Deadlines config.Deadlines `config:",inline"`
+	Shutdown  config.Shutdown  `config:",inline"`
+	Health    config.Health    `config:",inline"`
+	Tracing   config.Tracing   `config:",inline"`
+	Logging   config.Logging   `config:",inline"`
+	TLS       config.ServerTLS `config:",inline"`
+}
+
+func defaultSettings() settings {
+	return settings{
+		Addr:        "0.0.0.0:50052",
+		HTTPAddr:    "0.0.0.0:8082",
+		MetricsAddr: "0.0.0.0:9092",
+		AdminAddr:   "127.0.0.1:9192",
+		Deadlines:   config.Deadlines{Default: 30 * time.Second},
+		Shutdown:    config.DefaultShutdown(),
+		Health:      config.DefaultHealth(),
+		Tracing:     config.DefaultTracing(),
+		Logging:     config.DefaultLogging(),
+		TLS:         config.ServerTLS{ReloadInterval: 30 * time.Second},
+	}
+}
+
+func (c *settings) Check(p *config.Problems) {
+	p.Addr("addr", c.Addr, false)
+	p.Addr("http-addr", c.HTTPAddr, true)
+	p.Addr("metrics-addr", c.MetricsAddr, true)
+	p.Addr("admin-addr", c.AdminAddr, true)
+	p.Distinct(map[string]string{"addr": c.Addr, "http-addr": c.HTTPAddr, "metrics-addr": c.MetricsAddr, "admin-addr": c.AdminAddr})
+	c.Deadlines.Check(p)
+	c.Shutdown.Check(p)
+	c.Health.Check(p)
+	c.Tracing.Check(p)
+	c.Logging.Check(p)
+	c.TLS.Check(p, false)
+}
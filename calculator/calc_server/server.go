@@ -2,63 +2,144 @@ package main
 
 import (
 	"context"
-	"fmt"
-	"log"
-	"math"
+	"crypto/tls"
 	"net"
+	"net/http"
+	"strings"
 
+	calcservice "github.com/dipjyotimetia/gogrpc/calculator/calcService"
 	"github.com/dipjyotimetia/gogrpc/calculator/calcpb"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+	"github.com/dipjyotimetia/gogrpc/internal/deadline"
+	"github.com/dipjyotimetia/gogrpc/internal/gateway"
+	"github.com/dipjyotimetia/gogrpc/internal/grpcweb"
+	"github.com/dipjyotimetia/gogrpc/internal/healthcheck"
+	"github.com/dipjyotimetia/gogrpc/internal/lifecycle"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/metrics"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
+	"github.com/dipjyotimetia/gogrpc/internal/validation"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/grpc/status"
 )
 
-type server struct{}
-
-func (*server) Sum(ctx context.Context, req *calcpb.SumRequest) (*calcpb.SumResponse, error) {
-	fmt.Printf("Sum function was invoked %v\n", req)
-	firstArgument := req.GetFirstNumber()
-	secondArgument := req.GetSecondNumber()
-
-	result := firstArgument + secondArgument
-	res := &calcpb.SumResponse{
-		Result: result,
+func main() {
+	cfg := defaultSettings()
+	effective, err := config.Load("calc", &cfg)
+	if err != nil {
+		logging.Default().Fatal("invalid configuration", "error", err)
 	}
-	return res, nil
-}
+	logger, payloads := cfg.Logging.Logger()
+	logger.Info("configuration", effective.Fields()...)
 
-func (*server) SquareRoot(ctx context.Context, req *calcpb.SquareRootRequest) (*calcpb.SquareRootResponse, error) {
-	fmt.Printf("Received sqr root rpc %v", req)
-
-	number := req.GetNumber()
-	if number < 0 {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			fmt.Sprintf("Received a negative number %v", number),
-		)
+	lis, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		logger.Fatal("failed to listen", "addr", cfg.Addr, "error", err)
 	}
-	return &calcpb.SquareRootResponse{
-		NumberRoot: math.Sqrt(float64(number)),
-	}, nil
-}
-
-func main() {
 
-	fmt.Println("Hello calc")
-
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+	registry := metrics.NewRegistry()
+	rpcMetrics := metrics.NewServerRPC(registry)
+	tracer, err := cfg.Tracing.Tracer("calc", logger)
 	if err != nil {
-		log.Fatalf("failed to listen server")
+		logger.Fatal("failed to start tracing", "error", err)
 	}
-
-	s := grpc.NewServer()
-	calcpb.RegisterSumServiceServer(s, &server{})
+	deadlines, err := cfg.Deadlines.Policy()
+	if err != nil {
+		logger.Fatal("invalid deadlines", "error", err)
+	}
+	interceptors := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(tracer), logging.UnaryServerInterceptor(logger, payloads), rpcMetrics.UnaryServerInterceptor(), rpcerr.UnaryServerInterceptor(), mtls.UnaryServerInterceptor(), deadline.UnaryServerInterceptor(deadlines), validation.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(tracer), logging.StreamServerInterceptor(logger, payloads), rpcMetrics.StreamServerInterceptor(), rpcerr.StreamServerInterceptor(), mtls.StreamServerInterceptor(), deadline.StreamServerInterceptor(deadlines), validation.StreamServerInterceptor()),
+	}
+	serverOpts := interceptors
+	monitor := cfg.Health.Monitor(logger)
+	// the gRPC-Web mux terminates TLS, native calls and browsers share the port
+	var tlsConfig *tls.Config
+	if cfg.TLS.Enabled() {
+		certs, err := mtls.NewReloader(cfg.TLS.Cert, cfg.TLS.Key, cfg.TLS.ClientCA, logger)
+		if err != nil {
+			logger.Fatal("failed loading certificates", "error", err)
+		}
+		monitor.AddCheck("tls certificate", healthcheck.CertificateExpiry(certs.NotAfter))
+		if cfg.TLS.ReloadInterval > 0 {
+			go certs.Watch(context.Background(), cfg.TLS.ReloadInterval)
+		}
+		tlsConfig = certs.ServerConfig()
+		serverOpts = append(serverOpts, grpc.Creds(grpcweb.TLSCredentials()))
+	}
+	calc := calcservice.New()
+	register := func(s *grpc.Server) { calcpb.RegisterSumServiceServer(s, calc) }
+	s := grpc.NewServer(serverOpts...)
+	register(s)
+	monitor.AddService(calcservice.ServiceName)
+	monitor.Register(s)
+	monitor.Start()
 
 	reflection.Register(s)
+	web, err := grpcweb.NewServer(s, lis, tlsConfig, register, interceptors, grpcweb.AllowedOrigins(strings.Split(cfg.WebOrigins, ",")...))
+	if err != nil {
+		logger.Fatal("failed to start gRPC-Web", "error", err)
+	}
 
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	lc := cfg.Shutdown.Lifecycle(logger)
+	lc.AddDependency("tracer", tracer.Shutdown)
+	lc.SetHealth(monitor.Server())
+	lc.AddServer("server", web)
+	lc.AddDependency("health checks", monitor.Stop)
+	if cfg.MetricsAddr != "" {
+		metricsServer := metrics.NewServer(cfg.MetricsAddr, registry, map[string]http.Handler{
+			"/debug/logging": logging.ReadOnlyHandler(logger, payloads),
+			"/healthz":       monitor,
+			"/readyz":        monitor,
+		})
+		lc.AddServer("metrics server", lifecycle.HTTP(metricsServer))
+		go func() {
+			logger.Info("serving metrics", "addr", cfg.MetricsAddr)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to serve metrics", "error", err)
+			}
+		}()
+	}
+	if cfg.AdminAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/logging", logging.Handler(logger, payloads))
+		adminServer := &http.Server{Addr: cfg.AdminAddr, Handler: mux}
+		lc.AddServer("admin server", lifecycle.HTTP(adminServer))
+		go func() {
+			logger.Info("serving admin endpoints", "addr", cfg.AdminAddr)
+			if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to serve admin endpoints", "error", err)
+			}
+		}()
+	}
+	if cfg.HTTPAddr != "" {
+		gw, stopGateway, err := gateway.InProcess(func(s *grpc.Server) {
+			calcpb.RegisterSumServiceServer(s, calcservice.New())
+		}, interceptors...)
+		if err != nil {
+			logger.Fatal("failed to start gateway", "error", err)
+		}
+		lc.AddDependency("gateway", func(context.Context) error { stopGateway(); return nil })
+		mux := http.NewServeMux()
+		mux.Handle("/v1/", gw)
+		mux.Handle("/healthz", monitor)
+		mux.Handle("/readyz", monitor)
+		httpServer := &http.Server{Addr: cfg.HTTPAddr, Handler: mux, TLSConfig: tlsConfig}
+		lc.AddServer("gateway server", lifecycle.HTTP(httpServer))
+		go func() {
+			logger.Info("serving gateway", "addr", cfg.HTTPAddr)
+			if err := gateway.Serve(httpServer); err != nil {
+				logger.Fatal("failed to serve gateway", "error", err)
+			}
+		}()
 	}
 
+	go func() {
+		if err := web.Serve(); err != nil {
+			logger.Fatal("failed to serve", "error", err)
+		}
+	}()
+	lc.Wait()
 }
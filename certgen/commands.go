@@ -0,0 +1,414 @@
+package main
+
+import (
+	"crypto"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// keyFlags registers the flags choosing the type of new keys.
+type keyFlags struct {
+	keyType string
+	rsaBits int
+}
+
+func registerKeyFlags(fs *flag.FlagSet) *keyFlags {
+	k := &keyFlags{}
+	fs.StringVar(&k.keyType, "key-type", "ecdsa", "type of new keys: ecdsa (P-256), ed25519 or rsa; browsers do not accept ed25519 certificates")
+	fs.IntVar(&k.rsaBits, "rsa-bits", 3072, "size of RSA keys")
+	return k
+}
+
+func (k *keyFlags) generate() (crypto.Signer, error) {
+	return generateKey(k.keyType, k.rsaBits)
+}
+
+// leafFlags are the flags of a server or client certificate.
+type leafFlags struct {
+	name, cn       string
+	dns, ips, uris string
+	days           int
+}
+
+func registerLeafFlags(fs *flag.FlagSet, name, cn, dns, ips string) *leafFlags {
+	l := &leafFlags{}
+	fs.StringVar(&l.name, "name", name, "name of the files written, NAME.crt and NAME.pem")
+	fs.StringVar(&l.cn, "cn", cn, "common name of the certificate")
+	fs.StringVar(&l.dns, "dns", dns, "comma separated DNS names")
+	fs.StringVar(&l.ips, "ip", ips, "comma separated IP addresses")
+	fs.StringVar(&l.uris, "uri", "", "comma separated URIs, such as spiffe://example.org/ns/default/sa/greeter")
+	fs.IntVar(&l.days, "days", 365, "days the certificate is valid")
+	return l
+}
+
+func issueCA(dir, cn string, days int, keys *keyFlags, force bool) error {
+	certFile, keyFile := paths(dir, caName)
+	if err := checkNew(force, certFile, keyFile); err != nil {
+		return err
+	}
+	key, err := keys.generate()
+	if err != nil {
+		return err
+	}
+	cert, err := sign(caTemplate(cn, time.Duration(days)*day), key, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := write(certFile, keyFile, cert, key); err != nil {
+		return err
+	}
+	printIssued(certFile, keyFile, cert)
+	return nil
+}
+
+func issueLeaf(dir string, l *leafFlags, usage x509.ExtKeyUsage, keys *keyFlags, force bool) error {
+	if l.name == caName || l.name == "" || strings.ContainsAny(l.name, `/\`) {
+		return fmt.Errorf("invalid name %q", l.name)
+	}
+	names, err := parseSANs(l.dns, l.ips, l.uris)
+	if err != nil {
+		return err
+	}
+	if usage == x509.ExtKeyUsageServerAuth && len(names.dns)+len(names.ips) == 0 {
+		return fmt.Errorf("a server certificate needs a DNS name or an IP address, clients do not check the common name")
+	}
+	certFile, keyFile := paths(dir, l.name)
+	if err := checkNew(force, certFile, keyFile); err != nil {
+		return err
+	}
+	ca, caKey, err := loadCA(dir)
+	if err != nil {
+		return err
+	}
+	key, err := keys.generate()
+	if err != nil {
+		return err
+	}
+	cert, err := sign(leafTemplate(l.cn, names, usage, time.Duration(l.days)*day), key, ca, caKey)
+	if err != nil {
+		return err
+	}
+	if err := write(certFile, keyFile, cert, key); err != nil {
+		return err
+	}
+	printIssued(certFile, keyFile, cert)
+	return nil
+}
+
+func printIssued(certFile, keyFile string, cert *x509.Certificate) {
+	fmt.Printf("wrote %s and %s: %s, %s, expires %s\n", certFile, keyFile, cert.Subject.CommonName, keyName(cert.PublicKey), cert.NotAfter.Format("2006-01-02"))
+}
+
+var initCommand = &command{
+	usage:   "[-dir DIR] [-key-type TYPE] [-force]",
+	summary: "create a CA, a server certificate for localhost and a client certificate",
+	run: func(fs *flag.FlagSet, args []string) error {
+		dir := fs.String("dir", "ssl", "directory of the certificates")
+		keys := registerKeyFlags(fs)
+		caDays := fs.Int("ca-days", 3650, "days the CA is valid")
+		days := fs.Int("days", 365, "days the server and client certificates are valid")
+		force := fs.Bool("force", false, "replace existing files")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() != 0 {
+			return errUsage
+		}
+		if err := issueCA(*dir, "gogrpc development CA", *caDays, keys, *force); err != nil {
+			return err
+		}
+		server := &leafFlags{name: "server", cn: "localhost", dns: "localhost", ips: "127.0.0.1,::1", days: *days}
+		if err := issueLeaf(*dir, server, x509.ExtKeyUsageServerAuth, keys, *force); err != nil {
+			return err
+		}
+		client := &leafFlags{name: "client", cn: "client", days: *days}
+		return issueLeaf(*dir, client, x509.ExtKeyUsageClientAuth, keys, *force)
+	},
+}
+
+var caCommand = &command{
+	usage:   "[-dir DIR] [-cn NAME] [-days N] [-key-type TYPE] [-force]",
+	summary: "create a CA signing the other certificates, DIR/ca.crt and DIR/ca.key",
+	run: func(fs *flag.FlagSet, args []string) error {
+		dir := fs.String("dir", "ssl", "directory of the certificates")
+		cn := fs.String("cn", "gogrpc development CA", "common name of the CA")
+		days := fs.Int("days", 3650, "days the CA is valid")
+		keys := registerKeyFlags(fs)
+		force := fs.Bool("force", false, "replace an existing CA; certificates it signed are no longer trusted")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() != 0 {
+			return errUsage
+		}
+		return issueCA(*dir, *cn, *days, keys, *force)
+	},
+}
+
+var serverCommand = &command{
+	usage:   "[-dir DIR] [-name NAME] [-cn NAME] [-dns NAMES] [-ip ADDRS] [-uri URIS] [-days N] [-key-type TYPE] [-force]",
+	summary: "issue a server certificate signed by the CA",
+	run: func(fs *flag.FlagSet, args []string) error {
+		dir := fs.String("dir", "ssl", "directory of the certificates")
+		leaf := registerLeafFlags(fs, "server", "localhost", "localhost", "127.0.0.1,::1")
+		keys := registerKeyFlags(fs)
+		force := fs.Bool("force", false, "replace existing files")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() != 0 {
+			return errUsage
+		}
+		return issueLeaf(*dir, leaf, x509.ExtKeyUsageServerAuth, keys, *force)
+	},
+}
+
+var clientCommand = &command{
+	usage:   "[-dir DIR] [-name NAME] [-cn NAME] [-dns NAMES] [-ip ADDRS] [-uri URIS] [-days N] [-key-type TYPE] [-force]",
+	summary: "issue a client certificate signed by the CA, for servers requiring mutual TLS",
+	run: func(fs *flag.FlagSet, args []string) error {
+		dir := fs.String("dir", "ssl", "directory of the certificates")
+		leaf := registerLeafFlags(fs, "client", "client", "", "")
+		keys := registerKeyFlags(fs)
+		force := fs.Bool("force", false, "replace existing files")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() != 0 {
+			return errUsage
+		}
+		return issueLeaf(*dir, leaf, x509.ExtKeyUsageClientAuth, keys, *force)
+	},
+}
+
+// resolve returns the directory and name of a certificate given by name,
+// such as server, or by path, such as ssl/server.crt.
+func resolve(dir, arg string) (string, string) {
+	if strings.HasSuffix(arg, ".crt") || strings.ContainsAny(arg, `/\`) {
+		return filepath.Dir(arg), strings.TrimSuffix(filepath.Base(arg), ".crt")
+	}
+	return dir, arg
+}
+
+// names returns the names of the certificates of dir, the CA first.
+func names(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.crt"))
+	if err != nil {
+		return nil, err
+	}
+	var list []string
+	for _, f := range files {
+		list = append(list, strings.TrimSuffix(filepath.Base(f), ".crt"))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i] == caName || list[j] != caName && list[i] < list[j]
+	})
+	return list, nil
+}
+
+var renewCommand = &command{
+	usage:   "[-dir DIR] [-within DURATION] [-days N] [-rekey] [NAME|FILE...]",
+	summary: "issue again certificates of DIR, or the ones named, keeping their names",
+	run: func(fs *flag.FlagSet, args []string) error {
+		dir := fs.String("dir", "ssl", "directory of the certificates")
+		within := fs.Duration("within", 0, "only renew certificates expiring within this time, such as 720h; 0 renews them all")
+		days := fs.Int("days", 0, "days the new certificates are valid, 0 for as long as the old ones were")
+		rekey := fs.Bool("rekey", false, "generate new keys of the same type instead of keeping the old ones")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		list := fs.Args()
+		if len(list) == 0 {
+			var err error
+			if list, err = names(*dir); err != nil {
+				return err
+			}
+			if len(list) == 0 {
+				return fmt.Errorf("no certificate in %s, create them with 'certgen init'", *dir)
+			}
+		}
+		renewed := 0
+		for _, arg := range list {
+			d, name := resolve(*dir, arg)
+			ok, err := renew(d, name, *within, *days, *rekey)
+			if err != nil {
+				return fmt.Errorf("%s: %w", arg, err)
+			}
+			if ok {
+				renewed++
+			}
+		}
+		if renewed == 0 {
+			fmt.Println("nothing to renew")
+		}
+		return nil
+	},
+}
+
+// renew issues again the certificate name of dir when it expires within
+// the given time, or when it is not signed by the CA of dir anymore, as
+// after the CA got a new key.
+func renew(dir, name string, within time.Duration, days int, rekey bool) (bool, error) {
+	certFile, keyFile := paths(dir, name)
+	old, err := loadCertificate(certFile)
+	if err != nil {
+		return false, err
+	}
+	var ca *x509.Certificate
+	var caKey crypto.Signer
+	if name != caName {
+		if ca, caKey, err = loadCA(dir); err != nil {
+			return false, err
+		}
+	}
+	due := within == 0 || time.Until(old.NotAfter) < within
+	if ca != nil && old.CheckSignatureFrom(ca) != nil {
+		due = true
+	}
+	if !due {
+		fmt.Printf("%s: expires %s, not renewed\n", certFile, old.NotAfter.Format("2006-01-02"))
+		return false, nil
+	}
+
+	key, err := loadKey(keyFile)
+	if err != nil && !rekey {
+		return false, err
+	}
+	if rekey {
+		if key != nil {
+			key, err = sameKeyType(key)
+		} else {
+			key, err = generateKey("ecdsa", 0)
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	validity := old.NotAfter.Sub(old.NotBefore) - backdate
+	if days > 0 {
+		validity = time.Duration(days) * day
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		Subject:               old.Subject,
+		DNSNames:              old.DNSNames,
+		IPAddresses:           old.IPAddresses,
+		URIs:                  old.URIs,
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              old.KeyUsage,
+		ExtKeyUsage:           old.ExtKeyUsage,
+		BasicConstraintsValid: old.BasicConstraintsValid,
+		IsCA:                  old.IsCA,
+		MaxPathLen:            old.MaxPathLen,
+		MaxPathLenZero:        old.MaxPathLenZero,
+	}
+	if name == caName && !rekey {
+		// certificates the old CA signed name its key by this identifier
+		tmpl.SubjectKeyId = old.SubjectKeyId
+	}
+	cert, err := sign(tmpl, key, ca, caKey)
+	if err != nil {
+		return false, err
+	}
+	if !rekey {
+		key = nil
+	}
+	if err := write(certFile, keyFile, cert, key); err != nil {
+		return false, err
+	}
+	printIssued(certFile, keyFile, cert)
+	if name == caName && rekey {
+		fmt.Fprintf(os.Stderr, "the CA has a new key: renew the certificates it signed, and give %s to the clients\n", certFile)
+	}
+	return true, nil
+}
+
+var showCommand = &command{
+	usage:   "[-dir DIR] [NAME|FILE...]",
+	summary: "print the certificates of DIR, or the ones named, and check them against the CA",
+	run: func(fs *flag.FlagSet, args []string) error {
+		dir := fs.String("dir", "ssl", "directory of the certificates")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		list := fs.Args()
+		if len(list) == 0 {
+			var err error
+			if list, err = names(*dir); err != nil {
+				return err
+			}
+		}
+		for i, arg := range list {
+			if i > 0 {
+				fmt.Println()
+			}
+			d, name := resolve(*dir, arg)
+			if err := show(d, name); err != nil {
+				return fmt.Errorf("%s: %w", arg, err)
+			}
+		}
+		return nil
+	},
+}
+
+func serverAuth(cert *x509.Certificate) bool {
+	for _, u := range cert.ExtKeyUsage {
+		if u == x509.ExtKeyUsageServerAuth {
+			return true
+		}
+	}
+	return false
+}
+
+func show(dir, name string) error {
+	certFile, _ := paths(dir, name)
+	cert, err := loadCertificate(certFile)
+	if err != nil {
+		return err
+	}
+	fmt.Println(certFile)
+	fmt.Printf("  subject   %s\n", cert.Subject)
+	fmt.Printf("  issuer    %s\n", cert.Issuer)
+	fmt.Printf("  key       %s\n", keyName(cert.PublicKey))
+	var sans []string
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, u := range cert.URIs {
+		sans = append(sans, u.String())
+	}
+	switch {
+	case len(sans) > 0:
+		fmt.Printf("  names     %s\n", strings.Join(sans, ", "))
+	case serverAuth(cert):
+		fmt.Printf("  names     none, clients verifying the server name reject it\n")
+	}
+	state := fmt.Sprintf("expires in %d days", int(time.Until(cert.NotAfter)/day))
+	switch now := time.Now(); {
+	case now.After(cert.NotAfter):
+		state = "EXPIRED"
+	case now.Before(cert.NotBefore):
+		state = "NOT YET VALID"
+	}
+	fmt.Printf("  valid     %s to %s, %s\n", cert.NotBefore.Format("2006-01-02"), cert.NotAfter.Format("2006-01-02"), state)
+	if name != caName {
+		if ca, err := loadCertificate(filepath.Join(dir, "ca.crt")); err == nil {
+			verdict := "yes"
+			if err := cert.CheckSignatureFrom(ca); err != nil {
+				verdict = "NO, " + err.Error()
+			}
+			fmt.Printf("  signed by %s: %s\n", filepath.Join(dir, "ca.crt"), verdict)
+		}
+	}
+	return nil
+}
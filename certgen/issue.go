@@ -0,0 +1,294 @@
+package main
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// caName is the name of the CA files.
+const caName = "ca"
+
+// backdate covers clocks of other machines running a little late.
+const backdate = 5 * time.Minute
+
+// paths returns the certificate and key files of name in dir.
+func paths(dir, name string) (certFile, keyFile string) {
+	if name == caName {
+		return filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+	}
+	return filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".pem")
+}
+
+// generateKey creates a key of type ecdsa (P-256), ed25519 or rsa.
+func generateKey(keyType string, rsaBits int) (crypto.Signer, error) {
+	switch keyType {
+	case "ecdsa":
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "ed25519":
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	case "rsa":
+		if rsaBits < 2048 {
+			return nil, fmt.Errorf("RSA keys need at least 2048 bits, not %d", rsaBits)
+		}
+		return rsa.GenerateKey(rand.Reader, rsaBits)
+	}
+	return nil, fmt.Errorf("unknown key type %q, use ecdsa, ed25519 or rsa", keyType)
+}
+
+// sameKeyType creates a key of the type of key.
+func sameKeyType(key crypto.Signer) (crypto.Signer, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return ecdsa.GenerateKey(k.Curve, rand.Reader)
+	case ed25519.PrivateKey:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	case *rsa.PrivateKey:
+		return rsa.GenerateKey(rand.Reader, k.N.BitLen())
+	}
+	return nil, fmt.Errorf("unsupported key %T", key)
+}
+
+func keyName(key crypto.PublicKey) string {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return "ECDSA " + k.Curve.Params().Name
+	case ed25519.PublicKey:
+		return "Ed25519"
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", k.N.BitLen())
+	}
+	return fmt.Sprintf("%T", key)
+}
+
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+// sans holds the subject alternative names of a certificate.
+type sans struct {
+	dns  []string
+	ips  []net.IP
+	uris []*url.URL
+}
+
+// parseSANs parses comma separated DNS names, IP addresses and URIs.
+func parseSANs(dns, ips, uris string) (sans, error) {
+	var s sans
+	s.dns = splitList(dns)
+	for _, v := range splitList(ips) {
+		ip := net.ParseIP(v)
+		if ip == nil {
+			return s, fmt.Errorf("%q is not an IP address", v)
+		}
+		s.ips = append(s.ips, ip)
+	}
+	for _, v := range splitList(uris) {
+		u, err := url.Parse(v)
+		if err != nil {
+			return s, err
+		}
+		if u.Scheme == "" {
+			return s, fmt.Errorf("URI %q has no scheme", v)
+		}
+		s.uris = append(s.uris, u)
+	}
+	return s, nil
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// caTemplate returns the template of a CA certificate.
+func caTemplate(cn string, validity time.Duration) *x509.Certificate {
+	now := time.Now()
+	return &x509.Certificate{
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+}
+
+// leafTemplate returns the template of a server or client certificate.
+func leafTemplate(cn string, names sans, usage x509.ExtKeyUsage, validity time.Duration) *x509.Certificate {
+	now := time.Now()
+	return &x509.Certificate{
+		Subject:               pkix.Name{CommonName: cn},
+		DNSNames:              names.dns,
+		IPAddresses:           names.ips,
+		URIs:                  names.uris,
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{usage},
+		BasicConstraintsValid: true,
+	}
+}
+
+// sign issues tmpl for key, signed by the CA, or self-signed when ca is nil.
+func sign(tmpl *x509.Certificate, key crypto.Signer, ca *x509.Certificate, caKey crypto.Signer) (*x509.Certificate, error) {
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, err
+	}
+	tmpl.SerialNumber = serial
+	if _, ok := key.(*rsa.PrivateKey); ok && !tmpl.IsCA {
+		tmpl.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+	if ca == nil {
+		ca, caKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, key.Public(), caKey)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// loadCertificate reads the PEM certificate of certFile.
+func loadCertificate(certFile string) (*x509.Certificate, error) {
+	data, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%s: no PEM certificate", certFile)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// loadKey reads a PEM key, PKCS #8 or in the older PKCS #1 and SEC 1
+// encodings. Encrypted keys, such as those of openssl genrsa -des3, are not
+// supported.
+func loadKey(keyFile string) (crypto.Signer, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM key", keyFile)
+	}
+	if block.Headers["Proc-Type"] != "" || block.Type == "ENCRYPTED PRIVATE KEY" {
+		return nil, fmt.Errorf("%s: encrypted keys are not supported, renew with -rekey", keyFile)
+	}
+	var key interface{}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", keyFile, err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s: unsupported key %T", keyFile, key)
+	}
+	return signer, nil
+}
+
+// loadCA reads the CA of dir.
+func loadCA(dir string) (*x509.Certificate, crypto.Signer, error) {
+	certFile, keyFile := paths(dir, caName)
+	ca, err := loadCertificate(certFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("no CA in %s, create one with 'certgen ca' or 'certgen init'", dir)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ca.IsCA {
+		return nil, nil, fmt.Errorf("%s is not a CA certificate", certFile)
+	}
+	key, err := loadKey(keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ca, key, nil
+}
+
+// checkNew fails when certFile or keyFile exist, unless force is set.
+func checkNew(force bool, files ...string) error {
+	if force {
+		return nil
+	}
+	for _, f := range files {
+		if _, err := os.Stat(f); err == nil {
+			return fmt.Errorf("%s exists, use -force to replace it or 'certgen renew' to renew it", f)
+		}
+	}
+	return nil
+}
+
+// write saves cert and key. Each file is written aside and renamed into
+// place, so servers watching them never read half a file. The key is
+// written first: a server reloading in between sees a key not matching its
+// certificate and keeps the one it has until the certificate follows.
+func write(certFile, keyFile string, cert *x509.Certificate, key crypto.Signer) error {
+	if key != nil {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+			return err
+		}
+	}
+	return writeFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0644)
+}
+
+func writeFile(name string, data []byte, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), name)
+}
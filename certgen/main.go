@@ -0,0 +1,92 @@
+// Command certgen issues the certificates the services use for TLS and
+// mutual TLS in development.
+//
+//	certgen init   [flags]          create a CA, a server and a client certificate
+//	certgen ca     [flags]          create a CA
+//	certgen server [flags]          issue a server certificate signed by the CA
+//	certgen client [flags]          issue a client certificate signed by the CA
+//	certgen renew  [flags] NAME...  issue again certificates close to their expiry
+//	certgen show   NAME...          print certificates
+//
+// Files are written to -dir, ssl by default, in the layout the servers and
+// clients expect: ca.crt and ca.key for the CA, NAME.crt and NAME.pem for
+// the others, keys being unencrypted PKCS #8. Run from the repository root,
+//
+//	go run ./certgen init
+//
+// is all the greet server and clients need for -tls.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// errUsage makes main print the command usage and exit with status 2.
+var errUsage = errors.New("usage")
+
+type command struct {
+	usage   string
+	summary string
+	run     func(fs *flag.FlagSet, args []string) error
+}
+
+var commands = map[string]*command{
+	"init":   initCommand,
+	"ca":     caCommand,
+	"server": serverCommand,
+	"client": clientCommand,
+	"renew":  renewCommand,
+	"show":   showCommand,
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: certgen COMMAND [flags]")
+	fmt.Fprintln(os.Stderr)
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Run 'certgen COMMAND -h' for the flags of a command.")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	name := os.Args[1]
+	if name == "-h" || name == "--help" || name == "help" {
+		usage()
+		return
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "certgen: unknown command %q\n", name)
+		usage()
+		os.Exit(2)
+	}
+
+	fs := flag.NewFlagSet("certgen "+name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: certgen %s %s\n\n%s\n\n", name, cmd.usage, cmd.summary)
+		fs.PrintDefaults()
+	}
+	err := cmd.run(fs, os.Args[2:])
+	if errors.Is(err, errUsage) {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "certgen %s: %v\n", name, err)
+		os.Exit(1)
+	}
+}
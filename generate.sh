@@ -1,5 +1,9 @@
 #!/bin/bash
 
-protoc greet/greetpb/greet.proto --go_out=plugins=grpc:.
-protoc calculator/calcpb/calc.proto --go_out=plugins=grpc:.
-protoc blog/blogpb/blog.proto --go_out=plugins=grpc:.
\ No newline at end of file
+# third_party holds validate/validate.proto from protoc-gen-validate and the
+# google/api annotations used for the HTTP/JSON gateway
+protoc -I . -I third_party greet/greetpb/greet.proto --go_out=plugins=grpc:. --validate_out="lang=go:."
+protoc -I . -I third_party calculator/calcpb/calc.proto --go_out=plugins=grpc:. --validate_out="lang=go:."
+protoc -I . -I third_party blog/blogpb/blog.proto blog/blogpb/author.proto blog/blogpb/admin.proto --go_out=plugins=grpc:. --validate_out="lang=go:."
+# the logging options carry a full go_package, the others import it from there
+protoc -I . internal/logging/logpb/logging.proto --go_out=paths=source_relative:.
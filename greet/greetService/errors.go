@@ -0,0 +1,20 @@
+package greetservice
+
+import (
+	"errors"
+
+	"github.com/dipjyotimetia/gogrpc/internal/room"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	errTooManyGreetings  = errors.New("too many greetings in the stream")
+	errGreetingsTooLarge = errors.New("greetings of the stream too large")
+)
+
+func init() {
+	rpcerr.Register(room.ErrSlowConsumer, rpcerr.Mapping{Code: codes.ResourceExhausted, Reason: "SLOW_CONSUMER"})
+	rpcerr.Register(errTooManyGreetings, rpcerr.Mapping{Code: codes.ResourceExhausted, Reason: "TOO_MANY_MESSAGES"})
+	rpcerr.Register(errGreetingsTooLarge, rpcerr.Mapping{Code: codes.ResourceExhausted, Reason: "STREAM_TOO_LARGE"})
+}
@@ -0,0 +1,45 @@
+package greetservice
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dipjyotimetia/gogrpc/greet/greetpb"
+	"github.com/dipjyotimetia/gogrpc/internal/i18n"
+	"google.golang.org/grpc/metadata"
+)
+
+// greeting is the data the catalogue templates see.
+type greeting struct {
+	FirstName string
+	LastName  string
+	FullName  string
+	Names     string
+	N         int
+	Count     int
+}
+
+func newGreeting(g *greetpb.Greeting) greeting {
+	return greeting{
+		FirstName: g.GetFirstName(),
+		LastName:  g.GetLastName(),
+		FullName:  strings.TrimSpace(g.GetFirstName() + " " + g.GetLastName()),
+	}
+}
+
+// localizer picks the locale of a request: its locale field, else the
+// accept-language metadata, else the fallback of the catalogue.
+func (s *Server) localizer(ctx context.Context, locale string) *i18n.Localizer {
+	if locale != "" {
+		return s.catalog.Localizer(locale)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return s.catalog.Localizer(i18n.ParseAcceptLanguage(strings.Join(md.Get("accept-language"), ","))...)
+}
+
+func styleName(style greetpb.Style) string {
+	if style == greetpb.Style_STYLE_CASUAL {
+		return "casual"
+	}
+	return "formal"
+}
@@ -0,0 +1,203 @@
+package greetservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+
+	"github.com/dipjyotimetia/gogrpc/greet/greetpb"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/room"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+)
+
+// The room of a GreetEveryone call is named by its "room" metadata, the
+// lobby when missing.
+const (
+	roomHeader  = "room"
+	defaultRoom = "lobby"
+)
+
+var roomName = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+
+var roomEvents = map[room.Kind]struct {
+	event greetpb.RoomEvent
+	key   string
+}{
+	room.Message: {greetpb.RoomEvent_ROOM_EVENT_MESSAGE, "greet_everyone"},
+	room.Join:    {greetpb.RoomEvent_ROOM_EVENT_JOIN, "greet_everyone_join"},
+	room.Leave:   {greetpb.RoomEvent_ROOM_EVENT_LEAVE, "greet_everyone_leave"},
+}
+
+// chatter is the payload of room events: who, in which style.
+type chatter struct {
+	greeting *greetpb.Greeting
+	style    greetpb.Style
+}
+
+// participant is the latest state sent by the client of a call.
+type participant struct {
+	mu     sync.Mutex
+	locale string
+	last   *chatter
+}
+
+func (p *participant) update(req *greetpb.GreetEveryoneRequest) (c *chatter, first bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if req.GetLocale() != "" {
+		p.locale = req.GetLocale()
+	}
+	first = p.last == nil
+	p.last = &chatter{greeting: req.GetGreeting(), style: req.GetStyle()}
+	return p.last, first
+}
+
+func (p *participant) state() (string, *chatter) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.locale, p.last
+}
+
+func callRoom(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	names := md.Get(roomHeader)
+	if len(names) == 0 {
+		return defaultRoom, nil
+	}
+	if !roomName.MatchString(names[0]) {
+		return "", rpcerr.InvalidArgument("invalid room", &errdetails.BadRequest_FieldViolation{
+			Field:       "metadata." + roomHeader,
+			Description: fmt.Sprintf("room must match %s", roomName),
+		})
+	}
+	return names[0], nil
+}
+
+// GreetEveryone joins the room of the call: every greeting sent is
+// broadcast to everyone in the room, presence included, after replaying
+// the last greetings of the room.
+func (s *Server) GreetEveryone(stream greetpb.GreetService_GreetEveryoneServer) error {
+	ctx := stream.Context()
+	name, err := callRoom(ctx)
+	if err != nil {
+		return err
+	}
+
+	p := &participant{}
+	sub := s.rooms.Join(name)
+	defer func() {
+		// nobody is told about participants who never said who they are
+		if _, last := p.state(); last != nil {
+			sub.Leave(last)
+		} else {
+			sub.Leave(nil)
+		}
+	}()
+
+	received := make(chan error, 1)
+	go func() {
+		received <- receive(stream, p, sub)
+	}()
+
+	for {
+		select {
+		case e, ok := <-sub.Events():
+			if !ok {
+				return rpcerr.Convert(sub.Err())
+			}
+			if err := s.send(stream, p, sub, e); err != nil {
+				return err
+			}
+		case err := <-received:
+			if err != io.EOF {
+				return err
+			}
+			// the client is done sending: deliver the events already
+			// queued, its own last greetings included, then leave
+			for {
+				select {
+				case e, ok := <-sub.Events():
+					if !ok {
+						return rpcerr.Convert(sub.Err())
+					}
+					if err := s.send(stream, p, sub, e); err != nil {
+						return err
+					}
+				case <-ctx.Done():
+					return rpcerr.Convert(ctx.Err())
+				default:
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// send renders e for the client and sends it.
+func (s *Server) send(stream greetpb.GreetService_GreetEveryoneServer, p *participant, sub *room.Subscription, e room.Event) error {
+	ctx := stream.Context()
+	res, err := s.render(ctx, p, sub, e)
+	if err != nil {
+		return rpcerr.Convert(err)
+	}
+	if err := stream.Send(res); err != nil {
+		logging.FromContext(ctx).Warn("sending to the client failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// receive publishes the greetings of the client, announcing it with the
+// first one.
+func receive(stream greetpb.GreetService_GreetEveryoneServer, p *participant, sub *room.Subscription) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				logging.FromContext(stream.Context()).Warn("reading the client stream failed", "error", err)
+			}
+			return err
+		}
+		c, first := p.update(req)
+		if first {
+			if _, err := sub.Publish(room.Join, c); err != nil {
+				return err
+			}
+		}
+		if _, err := sub.Publish(room.Message, c); err != nil {
+			return err
+		}
+	}
+}
+
+// render writes a room event in the locale of the receiver and the style
+// of the sender.
+func (s *Server) render(ctx context.Context, p *participant, sub *room.Subscription, e room.Event) (*greetpb.GreetEveryoneResponse, error) {
+	c, ok := e.Payload.(*chatter)
+	if !ok {
+		return nil, errors.New("room event without chatter")
+	}
+	kind := roomEvents[e.Kind]
+	locale, _ := p.state()
+	l := s.localizer(ctx, locale)
+	result, locale, err := l.Format(kind.key, styleName(c.style), 1, newGreeting(c.greeting))
+	if err != nil {
+		return nil, err
+	}
+	return &greetpb.GreetEveryoneResponse{
+		Result:   result,
+		Locale:   locale,
+		Event:    kind.event,
+		Room:     e.Room,
+		Sender:   c.greeting,
+		Sequence: e.Seq,
+		Replay:   e.Replay,
+		Dropped:  sub.Dropped(),
+	}, nil
+}
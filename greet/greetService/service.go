@@ -0,0 +1,251 @@
+// Package greetservice implements the greet.GreetService: localised
+// greetings, streams of them and GreetEveryone rooms.
+package greetservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/greet/greetpb"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+	"github.com/dipjyotimetia/gogrpc/internal/i18n"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"github.com/dipjyotimetia/gogrpc/internal/room"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ServiceName is the name of the service in health checks.
+const ServiceName = "greet.GreetService"
+
+// MethodMinDeadlines are the least deadlines the methods need, in the form
+// of the method-min-deadlines setting: GreetWithDeadLine works for 3s.
+const MethodMinDeadlines = "/greet.GreetService/GreetWithDeadLine=3s"
+
+// Settings of the greet service, inlined in the settings of the servers
+// hosting it.
+type Settings struct {
+	Locales              string `config:"locales" usage:"directory of the greeting catalogues, one <locale>.yaml each"`
+	RoomBuffer           int    `config:"room-buffer" usage:"events a GreetEveryone participant may fall behind"`
+	RoomHistory          int    `config:"room-history" usage:"greetings replayed to participants joining a room"`
+	RoomSlowConsumer     string `config:"room-slow-consumer" usage:"what happens to participants falling further behind: drop or disconnect"`
+	LongGreetMaxMessages int    `config:"long-greet-max-messages" usage:"greetings accepted in a LongGreet stream, 0 for no limit"`
+	LongGreetMaxBytes    int    `config:"long-greet-max-bytes" usage:"bytes of greetings accepted in a LongGreet stream, 0 for no limit"`
+}
+
+// DefaultSettings returns the default settings of the service.
+func DefaultSettings() Settings {
+	return Settings{
+		Locales:              "greet/locales",
+		RoomBuffer:           64,
+		RoomHistory:          20,
+		RoomSlowConsumer:     "drop",
+		LongGreetMaxMessages: 10000,
+		LongGreetMaxBytes:    1 << 20,
+	}
+}
+
+// Check adds the problems of the settings to p.
+func (c Settings) Check(p *config.Problems) {
+	p.File("locales", c.Locales, true)
+	p.Min("room-buffer", c.RoomBuffer, 1)
+	p.Min("room-history", c.RoomHistory, 0)
+	if _, err := room.ParsePolicy(c.RoomSlowConsumer); err != nil {
+		p.Add("room-slow-consumer", "%v", err)
+	}
+	p.Min("long-greet-max-messages", c.LongGreetMaxMessages, 0)
+	p.Min("long-greet-max-bytes", c.LongGreetMaxBytes, 0)
+}
+
+// Server implements greetpb.GreetServiceServer. A server registered on
+// several gRPC servers, as for native, gRPC-Web and gateway calls, shares
+// its rooms between them.
+type Server struct {
+	catalog *i18n.Catalog
+	rooms   *room.Hub
+	// limits of a LongGreet stream, 0 for none
+	longGreetMaxMessages int
+	longGreetMaxBytes    int
+}
+
+// New loads the greeting catalogues and returns the service.
+func New(c Settings) (*Server, error) {
+	policy, err := room.ParsePolicy(c.RoomSlowConsumer)
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := i18n.Load(c.Locales, "en")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load greetings: %w", err)
+	}
+	return &Server{
+		catalog:              catalog,
+		rooms:                room.NewHub(room.Config{Buffer: c.RoomBuffer, History: c.RoomHistory, Policy: policy}),
+		longGreetMaxMessages: c.LongGreetMaxMessages,
+		longGreetMaxBytes:    c.LongGreetMaxBytes,
+	}, nil
+}
+
+// Locales returns the locales greetings are available in.
+func (s *Server) Locales() []string {
+	return s.catalog.Locales()
+}
+
+func (s *Server) Greet(ctx context.Context, req *greetpb.GreetRequest) (*greetpb.GreetResponse, error) {
+	if id, ok := mtls.FromContext(ctx); ok {
+		logging.FromContext(ctx).Debug("greet called", "caller", id)
+	}
+	l := s.localizer(ctx, req.GetLocale())
+	result, locale, err := l.Format("greet", styleName(req.GetStyle()), 1, newGreeting(req.GetGreeting()))
+	if err != nil {
+		return nil, rpcerr.Convert(err)
+	}
+	res := &greetpb.GreetResponse{
+		Result: result,
+		Locale: locale,
+	}
+	return res, nil
+}
+
+// Defaults and bounds of the GreetManyTimes stream.
+const (
+	defaultGreetCount    = 10
+	defaultGreetInterval = time.Second
+)
+
+// GreetManyTimes sends count greetings, interval apart, numbered from 1.
+// The greetings only depend on the request, so a client reconnecting with
+// the last sequence it received in resume_after gets the rest of them.
+func (s *Server) GreetManyTimes(req *greetpb.GreetManyTimesRequest, stream greetpb.GreetService_GreetManyTimesServer) error {
+	ctx := stream.Context()
+	count := uint64(req.GetCount())
+	if count == 0 {
+		count = defaultGreetCount
+	}
+	// the validation interceptor keeps it between 0s and 1m
+	interval := defaultGreetInterval
+	if req.GetInterval() != nil {
+		interval = req.GetInterval().AsDuration()
+	}
+	if req.GetResumeAfter() > count {
+		return rpcerr.InvalidArgument("invalid request: resume_after is past the last greeting", &errdetails.BadRequest_FieldViolation{
+			Field:       "resume_after",
+			Description: fmt.Sprintf("value must be at most count (%d)", count),
+		})
+	}
+
+	l := s.localizer(ctx, req.GetLocale())
+	data := newGreeting(req.GetGreeting())
+	data.Count = int(count)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for seq := req.GetResumeAfter() + 1; seq <= count; seq++ {
+		select {
+		case <-ctx.Done():
+			logging.FromContext(ctx).Info("greet many times stopped", "sequence", seq-1, "error", ctx.Err())
+			return rpcerr.Convert(ctx.Err())
+		case <-timer.C:
+		}
+		data.N = int(seq)
+		result, locale, err := l.Format("greet_many_times", styleName(req.GetStyle()), data.N, data)
+		if err != nil {
+			return rpcerr.Convert(err)
+		}
+		res := &greetpb.GreetManyTimesResponse{
+			Result:   result,
+			Locale:   locale,
+			Sequence: seq,
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+		timer.Reset(interval)
+	}
+	return nil
+}
+
+// LongGreet sums up a stream of greetings. Streams over the message or
+// byte limits of the server end with RESOURCE_EXHAUSTED.
+func (s *Server) LongGreet(stream greetpb.GreetService_LongGreetServer) error {
+	ctx := stream.Context()
+	var first *greetpb.LongGreetRequest
+	res := &greetpb.LongGreetResponse{}
+	seen := map[string]bool{}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			//we have finished the client stream
+			if first == nil {
+				return stream.SendAndClose(res)
+			}
+			// the first message sets the locale and style of the summary
+			l := s.localizer(ctx, first.GetLocale())
+			data := greeting{Names: l.Join(res.DistinctNames), Count: len(res.DistinctNames)}
+			res.Result, res.Locale, err = l.Format("long_greet", styleName(first.GetStyle()), data.Count, data)
+			if err != nil {
+				return rpcerr.Convert(err)
+			}
+			return stream.SendAndClose(res)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				logging.FromContext(ctx).Info("long greet stopped", "count", res.Count, "error", ctx.Err())
+				return rpcerr.Convert(ctx.Err())
+			}
+			logging.FromContext(ctx).Warn("reading the client stream failed", "error", err)
+			return err
+		}
+
+		now := timestamppb.Now()
+		if first == nil {
+			first = req
+			res.FirstReceived = now
+		}
+		res.LastReceived = now
+		res.Count++
+		res.Bytes += uint64(proto.Size(req))
+		if s.longGreetMaxMessages > 0 && res.Count > uint64(s.longGreetMaxMessages) {
+			return rpcerr.Convert(errTooManyGreetings, rpcerr.WithMetadata("limit", strconv.Itoa(s.longGreetMaxMessages)))
+		}
+		if s.longGreetMaxBytes > 0 && res.Bytes > uint64(s.longGreetMaxBytes) {
+			return rpcerr.Convert(errGreetingsTooLarge, rpcerr.WithMetadata("limit", strconv.Itoa(s.longGreetMaxBytes)))
+		}
+		name := newGreeting(req.GetGreeting()).FullName
+		if key := strings.ToLower(name); !seen[key] {
+			seen[key] = true
+			res.DistinctNames = append(res.DistinctNames, name)
+		}
+	}
+}
+
+// greetWithDeadLineWork is how long GreetWithDeadLine works on a greeting.
+const greetWithDeadLineWork = 3 * time.Second
+
+func (s *Server) GreetWithDeadLine(ctx context.Context, req *greetpb.GreetWithDeadLineRequest) (*greetpb.GreetWithDeadLineResponse, error) {
+	work := time.NewTimer(greetWithDeadLineWork)
+	defer work.Stop()
+	select {
+	case <-ctx.Done():
+		// canceled by the client, or out of time
+		logging.FromContext(ctx).Info("greet with deadline stopped", "error", ctx.Err())
+		return nil, rpcerr.Convert(ctx.Err())
+	case <-work.C:
+	}
+	l := s.localizer(ctx, req.GetLocale())
+	result, locale, err := l.Format("greet_with_deadline", styleName(req.GetStyle()), 1, newGreeting(req.GetGreeting()))
+	if err != nil {
+		return nil, rpcerr.Convert(err)
+	}
+	res := &greetpb.GreetWithDeadLineResponse{
+		Result: result,
+		Locale: locale,
+	}
+	return res, nil
+}
@@ -5,30 +5,60 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"time"
 
 	"github.com/dipjyotimetia/gogrpc/greet/greetpb"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+	"github.com/dipjyotimetia/gogrpc/internal/deadline"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/metrics"
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+// settings of the client, from the config file, GREET_CLIENT_* variables and flags.
+type settings struct {
+	Target  string           `config:"target" usage:"address of the greet server"`
+	TLS     config.ClientTLS `config:",inline"`
+	Tracing config.Tracing   `config:",inline"`
+
+	PrintMetrics bool `config:"print-metrics" usage:"print the client metrics of the calls, in the Prometheus text format, when done"`
+}
+
+func (c *settings) Check(p *config.Problems) {
+	p.Addr("target", c.Target, false)
+	c.TLS.Check(p)
+	c.Tracing.Check(p)
+}
+
 func main() {
 	fmt.Println("Hello i am a client")
 
-	tls := false
-	opts := grpc.WithInsecure()
-	if tls {
-		certFile := "ssl/ca.crt" //certificate authority trust certificate
-		creds, sslError := credentials.NewClientTLSFromFile(certFile, "")
-		if sslError != nil {
-			log.Fatalf("Error while loading CA trust certificate %v", sslError)
-			return
-		}
-		opts = grpc.WithTransportCredentials(creds)
+	cfg := settings{Target: "localhost:50051", TLS: config.ClientTLS{CACert: "ssl/ca.crt"}, Tracing: config.DefaultTracing()}
+	if _, err := config.Load("greet_client", &cfg); err != nil {
+		log.Fatalf("%v", err)
 	}
-	cc, err := grpc.Dial("localhost:50051", opts)
+
+	opts, err := cfg.TLS.DialOption()
+	if err != nil {
+		log.Fatalf("Error while loading certificates %v", err)
+	}
+	registry := metrics.NewRegistry()
+	rpcMetrics := metrics.NewClientRPC(registry)
+	tracer, err := cfg.Tracing.Tracer("greet_client", logging.Default())
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	// keep 100ms of each deadline to tell a timeout from a lost answer
+	cc, err := grpc.Dial(cfg.Target, opts,
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor(tracer), rpcMetrics.UnaryClientInterceptor(), deadline.UnaryClientInterceptor(100*time.Millisecond)),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor(tracer), rpcMetrics.StreamClientInterceptor(), deadline.StreamClientInterceptor(100*time.Millisecond)),
+	)
 
 	if err != nil {
 		log.Fatalf("error is, %v", err)
@@ -44,6 +74,13 @@ func main() {
 
 	doUnaryWithDeadline(c, 5*time.Second)
 	doUnaryWithDeadline(c, 1*time.Second)
+
+	if err := tracer.Shutdown(context.Background()); err != nil {
+		fmt.Printf("Failed exporting the traces: %v\n", err)
+	}
+	if cfg.PrintMetrics {
+		registry.WriteText(os.Stdout)
+	}
 }
 
 func doUnary(c greetpb.GreetServiceClient) {
@@ -68,22 +105,43 @@ func doServerStreaming(c greetpb.GreetServiceClient) {
 		Greeting: &greetpb.Greeting{
 			FirstName: "Dipjyoti",
 			LastName:  "Metia",
-		}}
+		},
+		Count:    20,
+		Interval: durationpb.New(500 * time.Millisecond),
+	}
+	// reconnect after the server went away, continuing after the last
+	// greeting received
+	for attempt := 1; ; attempt++ {
+		err := greetManyTimes(c, req)
+		if err == nil {
+			return
+		}
+		if status.Code(err) != codes.Unavailable || attempt == 5 {
+			log.Fatalf("error while reading the stream %v", err)
+		}
+		fmt.Printf("Stream interrupted after %d, reconnecting: %v\n", req.ResumeAfter, err)
+		time.Sleep(time.Duration(attempt) * time.Second)
+	}
+}
+
+// greetManyTimes reads the stream, recording the last sequence received in
+// req.ResumeAfter.
+func greetManyTimes(c greetpb.GreetServiceClient, req *greetpb.GreetManyTimesRequest) error {
 	res, err := c.GreetManyTimes(context.Background(), req)
 	if err != nil {
-		log.Fatalf("error while calling greet rpc %v", err)
+		return err
 	}
 	for {
 		msg, err := res.Recv()
 		if err == io.EOF {
-			break
+			return nil
 		}
 		if err != nil {
-			log.Fatalf("error while reading the stream %v", err)
+			return err
 		}
-		log.Printf("Response from greet many times %v", msg.GetResult())
+		req.ResumeAfter = msg.GetSequence()
+		log.Printf("Response from greet many times %d: %v", msg.GetSequence(), msg.GetResult())
 	}
-
 }
 
 func doClientStreaming(c greetpb.GreetServiceClient) {
@@ -176,7 +234,8 @@ func doClientBidirectional(c greetpb.GreetServiceClient) {
 		},
 	}
 
-	stream, err := c.GreetEveryone(context.Background())
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "room", "lobby")
+	stream, err := c.GreetEveryone(ctx)
 	if err != nil {
 		log.Fatalf("Error while streaming")
 		return
@@ -203,7 +262,7 @@ func doClientBidirectional(c greetpb.GreetServiceClient) {
 				log.Fatalf("Error while receiving %v", err)
 				break
 			}
-			fmt.Printf("Received: %v\n", res.GetResult())
+			fmt.Printf("Received #%d %v in %s: %v\n", res.GetSequence(), res.GetEvent(), res.GetRoom(), res.GetResult())
 		}
 		close(waitC)
 	}()
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	greetservice "github.com/dipjyotimetia/gogrpc/greet/greetService"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+)
+
+// settings of the greet server, from the config file, GREET_* variables
+// and flags.
+type settings struct {
+	Addr        string `config:"addr" usage:"gRPC and gRPC-Web listen address"`
+	HTTPAddr    string `config:"http-addr" usage:"HTTP/JSON gateway listen address, empty to disable"`
+	MetricsAddr string `config:"metrics-addr" usage:"listen address of the Prometheus /metrics endpoint and the health probes, empty to disable"`
+	AdminAddr   string `config:"admin-addr" usage:"listen address of /debug/logging, changing the logging at runtime, empty to disable; keep it on loopback"`
+	WebOrigins  string `config:"grpc-web-origins" usage:"comma separated origins allowed to call over gRPC-Web from other sites, * for any, none when empty"`
+
+	Greet     greetservice.Settings `config:",inline"`
+	Deadlines config.Deadlines      `config:",inline"`
+	Shutdown  config.Shutdown       `config:",inline"`
+	Health    config.Health         `config:",inline"`
+	Tracing   config.Tracing        `config:",inline"`
+	Logging   config.Logging        `config:",inline"`
+	TLS       config.ServerTLS      `config:",inline"`
+}
+
+func defaultSettings() settings {
+	return settings{
+		Addr:        "0.0.0.0:50051",
+		HTTPAddr:    "0.0.0.0:8081",
+		MetricsAddr: "0.0.0.0:9091",
+		AdminAddr:   "127.0.0.1:9191",
+		Greet:       greetservice.DefaultSettings(),
+		Deadlines: config.Deadlines{
+			Default:        30 * time.Second,
+			MethodMinimums: greetservice.MethodMinDeadlines,
+		},
+		Shutdown: config.DefaultShutdown(),
+		Health:   config.DefaultHealth(),
+		Tracing:  config.DefaultTracing(),
+		Logging:  config.DefaultLogging(),
+		TLS: config.ServerTLS{
+			Cert:           "ssl/server.crt",
+			Key:            "ssl/server.pem",
+			ReloadInterval: 30 * time.Second,
+		},
+	}
+}
+
+func (c *settings) Check(p *config.Problems) {
+	p.Addr("addr", c.Addr, false)
+	p.Addr("http-addr", c.HTTPAddr, true)
+	p.Addr("metrics-addr", c.MetricsAddr, true)
+	p.Addr("admin-addr", c.AdminAddr, true)
+	p.Distinct(map[string]string{"addr": c.Addr, "http-addr": c.HTTPAddr, "metrics-addr": c.MetricsAddr, "admin-addr": c.AdminAddr})
+	c.Greet.Check(p)
+	c.Deadlines.Check(p)
+	c.Shutdown.Check(p)
+	c.Health.Check(p)
+	c.Tracing.Check(p)
+	c.Logging.Check(p)
+	// the gRPC-Web mux always serves TLS
+	c.TLS.Check(p, true)
+	if _, err := os.Stat(c.TLS.Cert); errors.Is(err, os.ErrNotExist) {
+		p.Add("tls-cert", "create development certificates with: go run ./certgen init")
+	}
+}
@@ -2,125 +2,141 @@ package main
 
 import (
 	"context"
-	"fmt"
-	"io"
-	"log"
 	"net"
-	"strconv"
-	"time"
+	"net/http"
+	"strings"
 
+	greetservice "github.com/dipjyotimetia/gogrpc/greet/greetService"
 	"github.com/dipjyotimetia/gogrpc/greet/greetpb"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+	"github.com/dipjyotimetia/gogrpc/internal/deadline"
+	"github.com/dipjyotimetia/gogrpc/internal/gateway"
+	"github.com/dipjyotimetia/gogrpc/internal/grpcweb"
+	"github.com/dipjyotimetia/gogrpc/internal/healthcheck"
+	"github.com/dipjyotimetia/gogrpc/internal/lifecycle"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/metrics"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
+	"github.com/dipjyotimetia/gogrpc/internal/validation"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/status"
 )
 
-type server struct{}
-
-func (*server) Greet(ctx context.Context, req *greetpb.GreetRequest) (*greetpb.GreetResponse, error) {
-	fmt.Printf("greet functions was invoked with %v\n", req)
-	firstName := req.GetGreeting().GetFirstName()
-	result := firstName
-	res := &greetpb.GreetResponse{
-		Result: result,
+func main() {
+	cfg := defaultSettings()
+	effective, err := config.Load("greet", &cfg)
+	if err != nil {
+		logging.Default().Fatal("invalid configuration", "error", err)
 	}
-	return res, nil
-}
+	logger, payloads := cfg.Logging.Logger()
+	logger.Info("configuration", effective.Fields()...)
 
-func (*server) GreetManyTimes(req *greetpb.GreetManyTimesRequest, stream greetpb.GreetService_GreetManyTimesServer) error {
-	fmt.Printf("greet many times functions streaming was invoked with %v\n", req)
-	firstName := req.GetGreeting().GetFirstName()
-	for i := 0; i < 10; i++ {
-		result := "Hello" + firstName + "" + strconv.Itoa(i)
-		res := &greetpb.GreetManyTimesResponse{
-			Result: result,
-		}
-		stream.Send(res)
-		time.Sleep(1000 * time.Millisecond)
+	// one server for native, gRPC-Web and gateway calls, they share the rooms
+	srv, err := greetservice.New(cfg.Greet)
+	if err != nil {
+		logger.Fatal("failed to load the greetings", "error", err)
 	}
-	return nil
-}
+	logger.Info("greeting", "locales", strings.Join(srv.Locales(), ","))
 
-func (*server) LongGreet(stream greetpb.GreetService_LongGreetServer) error {
-	fmt.Printf("Long greet many times functions streaming request was invoked with\n")
-	result := "Hello "
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			//we have finished the client stream
-			return stream.SendAndClose(&greetpb.LongGreetResponse{
-				Result: result,
-			})
-		}
-		if err != nil {
-			log.Fatalf("Error while reading client stream %v", err)
-		}
-		firstName := req.GetGreeting().GetFirstName()
-		result += firstName + "! "
+	lis, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		logger.Fatal("failed to listen", "addr", cfg.Addr, "error", err)
 	}
-}
-
-func (*server) GreetEveryone(stream greetpb.GreetService_GreetEveryoneServer) error {
-	fmt.Printf("Greet everyone many times functions bidirectional streaming request was invoked with\n")
-
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			return nil
-		}
-		if err != nil {
-			log.Fatalf("error while reading client stream %v", err)
-			return err
-		}
-
-		firstName := req.GetGreeting().GetFirstName()
-		result := "Hello" + firstName + "! "
-
-		sendErr := stream.Send(&greetpb.GreetEveryoneResponse{
-			Result: result,
-		})
-		if sendErr != nil {
-			log.Fatalf("Error while sending data to client %v", sendErr)
-			return sendErr
-		}
+	certs, sslErr := mtls.NewReloader(cfg.TLS.Cert, cfg.TLS.Key, cfg.TLS.ClientCA, logger)
+	if sslErr != nil {
+		logger.Fatal("failed loading certificates, create development ones with: go run ./certgen init", "error", sslErr)
 	}
-}
-
-func (*server) GreetWithDeadLine(ctx context.Context, req *greetpb.GreetWithDeadLineRequest) (*greetpb.GreetWithDeadLineResponse, error) {
-	fmt.Printf("greet functions was invoked with %v\n", req)
-	for i := 0; i < 3; i++ {
-		if ctx.Err() == context.Canceled {
-			fmt.Println("Client cancel the request")
-			return nil, status.Error(codes.Canceled, "the client cancel the request")
-		}
-		time.Sleep(1 * time.Second)
+	if cfg.TLS.ReloadInterval > 0 {
+		go certs.Watch(context.Background(), cfg.TLS.ReloadInterval)
 	}
-	firstName := req.GetGreeting().GetFirstName()
-	result := firstName
-	res := &greetpb.GreetWithDeadLineResponse{
-		Result: result,
+	tlsConfig := certs.ServerConfig()
+	registry := metrics.NewRegistry()
+	rpcMetrics := metrics.NewServerRPC(registry)
+	tracer, err := cfg.Tracing.Tracer("greet", logger)
+	if err != nil {
+		logger.Fatal("failed to start tracing", "error", err)
 	}
-	return res, nil
-}
-
-func main() {
-	fmt.Println("Hello world")
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+	deadlines, err := cfg.Deadlines.Policy()
 	if err != nil {
-		log.Fatalf("failed to listen server")
+		logger.Fatal("invalid deadlines", "error", err)
 	}
-	certFile := "ssl/server.crt"
-	keyFile := "ssl/server.pem"
-	creds, sslErr := credentials.NewServerTLSFromFile(certFile, keyFile)
-	if sslErr != nil {
-		log.Fatalf("Failed loading certificates %v", sslErr)
+	interceptors := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(tracer), logging.UnaryServerInterceptor(logger, payloads), rpcMetrics.UnaryServerInterceptor(), rpcerr.UnaryServerInterceptor(), mtls.UnaryServerInterceptor(), deadline.UnaryServerInterceptor(deadlines), validation.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(tracer), logging.StreamServerInterceptor(logger, payloads), rpcMetrics.StreamServerInterceptor(), rpcerr.StreamServerInterceptor(), mtls.StreamServerInterceptor(), deadline.StreamServerInterceptor(deadlines), validation.StreamServerInterceptor()),
+	}
+	// the gRPC-Web mux terminates TLS, native calls and browsers share the port
+	s := grpc.NewServer(append(interceptors, grpc.Creds(grpcweb.TLSCredentials()))...)
+	register := func(s *grpc.Server) { greetpb.RegisterGreetServiceServer(s, srv) }
+	register(s)
+	monitor := cfg.Health.Monitor(logger)
+	monitor.AddService(greetservice.ServiceName)
+	monitor.AddCheck("tls certificate", healthcheck.CertificateExpiry(certs.NotAfter))
+	monitor.Register(s)
+	monitor.Start()
+	web, err := grpcweb.NewServer(s, lis, tlsConfig, register, interceptors, grpcweb.AllowedOrigins(strings.Split(cfg.WebOrigins, ",")...))
+	if err != nil {
+		logger.Fatal("failed to start gRPC-Web", "error", err)
 	}
-	opts := grpc.Creds(creds)
-	s := grpc.NewServer(opts)
-	greetpb.RegisterGreetServiceServer(s, &server{})
 
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	lc := cfg.Shutdown.Lifecycle(logger)
+	lc.AddDependency("tracer", tracer.Shutdown)
+	lc.SetHealth(monitor.Server())
+	lc.AddServer("server", web)
+	lc.AddDependency("health checks", monitor.Stop)
+	if cfg.MetricsAddr != "" {
+		metricsServer := metrics.NewServer(cfg.MetricsAddr, registry, map[string]http.Handler{
+			"/debug/logging": logging.ReadOnlyHandler(logger, payloads),
+			"/healthz":       monitor,
+			"/readyz":        monitor,
+		})
+		lc.AddServer("metrics server", lifecycle.HTTP(metricsServer))
+		go func() {
+			logger.Info("serving metrics", "addr", cfg.MetricsAddr)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to serve metrics", "error", err)
+			}
+		}()
 	}
+	if cfg.AdminAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/logging", logging.Handler(logger, payloads))
+		adminServer := &http.Server{Addr: cfg.AdminAddr, Handler: mux}
+		lc.AddServer("admin server", lifecycle.HTTP(adminServer))
+		go func() {
+			logger.Info("serving admin endpoints", "addr", cfg.AdminAddr)
+			if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to serve admin endpoints", "error", err)
+			}
+		}()
+	}
+	if cfg.HTTPAddr != "" {
+		gw, stopGateway, err := gateway.InProcess(func(s *grpc.Server) {
+			greetpb.RegisterGreetServiceServer(s, srv)
+		}, interceptors...)
+		if err != nil {
+			logger.Fatal("failed to start gateway", "error", err)
+		}
+		lc.AddDependency("gateway", func(context.Context) error { stopGateway(); return nil })
+		mux := http.NewServeMux()
+		mux.Handle("/v1/", gw)
+		mux.Handle("/healthz", monitor)
+		mux.Handle("/readyz", monitor)
+		httpServer := &http.Server{Addr: cfg.HTTPAddr, Handler: mux, TLSConfig: tlsConfig}
+		lc.AddServer("gateway server", lifecycle.HTTP(httpServer))
+		go func() {
+			logger.Info("serving gateway", "addr", cfg.HTTPAddr)
+			if err := gateway.Serve(httpServer); err != nil {
+				logger.Fatal("failed to serve gateway", "error", err)
+			}
+		}()
+	}
+
+	go func() {
+		logger.Info("serving", "addr", cfg.Addr)
+		if err := web.Serve(); err != nil {
+			logger.Fatal("failed to serve", "error", err)
+		}
+	}()
+	lc.Wait()
 }
@@ -0,0 +1,1713 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: greet/greetpb/greet.proto
+
+package greetpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Greeting with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Greeting) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Greeting with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in GreetingMultiError, or nil if none found.
+func (m *Greeting) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Greeting) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetFirstName()); l < 1 || l > 100 {
+		err := GreetingValidationError{
+			field:  "FirstName",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLastName()) > 100 {
+		err := GreetingValidationError{
+			field:  "LastName",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GreetingMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetingMultiError is an error wrapping multiple validation errors returned
+// by Greeting.ValidateAll() if the designated constraints aren't met.
+type GreetingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetingMultiError) AllErrors() []error { return m }
+
+// GreetingValidationError is the validation error returned by Greeting.Validate
+// if the designated constraints aren't met.
+type GreetingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetingValidationError) ErrorName() string { return "GreetingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GreetingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreeting.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetingValidationError{}
+
+// Validate checks the field values on GreetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GreetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in GreetRequestMultiError, or nil if
+// none found.
+func (m *GreetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := GreetRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := GreetRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+	if !_GreetRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := GreetRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Style_name[int32(m.GetStyle())]; !ok {
+		err := GreetRequestValidationError{
+			field:  "Style",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GreetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetRequestMultiError is an error wrapping multiple validation errors
+// returned by GreetRequest.ValidateAll() if the designated constraints aren't
+// met.
+type GreetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetRequestMultiError) AllErrors() []error { return m }
+
+// GreetRequestValidationError is the validation error returned by
+// GreetRequest.Validate if the designated constraints aren't met.
+type GreetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetRequestValidationError) ErrorName() string { return "GreetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GreetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetRequestValidationError{}
+
+var _GreetRequest_Locale_Pattern = regexp.MustCompile("^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$")
+
+// Validate checks the field values on GreetResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GreetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GreetResponseMultiError, or
+// nil if none found.
+func (m *GreetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return GreetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetResponseMultiError is an error wrapping multiple validation errors
+// returned by GreetResponse.ValidateAll() if the designated constraints aren't
+// met.
+type GreetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetResponseMultiError) AllErrors() []error { return m }
+
+// GreetResponseValidationError is the validation error returned by
+// GreetResponse.Validate if the designated constraints aren't met.
+type GreetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetResponseValidationError) ErrorName() string { return "GreetResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GreetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetResponseValidationError{}
+
+// Validate checks the field values on GreetManyTimesRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GreetManyTimesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetManyTimesRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GreetManyTimesRequestMultiError, or nil if none found.
+func (m *GreetManyTimesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetManyTimesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := GreetManyTimesRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetManyTimesRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetManyTimesRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetManyTimesRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := GreetManyTimesRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+	if !_GreetManyTimesRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := GreetManyTimesRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Style_name[int32(m.GetStyle())]; !ok {
+		err := GreetManyTimesRequestValidationError{
+			field:  "Style",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() > 100000 {
+		err := GreetManyTimesRequestValidationError{
+			field:  "Count",
+			reason: "value must be less than or equal to 100000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = GreetManyTimesRequestValidationError{
+				field:  "Interval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			lte := time.Duration(60*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+			if dur < gte || dur > lte {
+				err := GreetManyTimesRequestValidationError{
+					field:  "Interval",
+					reason: "value must be inside range [0s, 1m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+		}
+	}
+
+	if m.GetResumeAfter() > 100000 {
+		err := GreetManyTimesRequestValidationError{
+			field:  "ResumeAfter",
+			reason: "value must be less than or equal to 100000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GreetManyTimesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetManyTimesRequestMultiError is an error wrapping multiple validation
+// errors returned by GreetManyTimesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GreetManyTimesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetManyTimesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetManyTimesRequestMultiError) AllErrors() []error { return m }
+
+// GreetManyTimesRequestValidationError is the validation error returned by
+// GreetManyTimesRequest.Validate if the designated constraints aren't met.
+type GreetManyTimesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetManyTimesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetManyTimesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetManyTimesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetManyTimesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetManyTimesRequestValidationError) ErrorName() string {
+	return "GreetManyTimesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetManyTimesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetManyTimesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetManyTimesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetManyTimesRequestValidationError{}
+
+var _GreetManyTimesRequest_Locale_Pattern = regexp.MustCompile("^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$")
+
+// Validate checks the field values on GreetManyTimesResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GreetManyTimesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetManyTimesResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GreetManyTimesResponseMultiError, or nil if none found.
+func (m *GreetManyTimesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetManyTimesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	// no validation rules for Locale
+
+	// no validation rules for Sequence
+
+	if len(errors) > 0 {
+		return GreetManyTimesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetManyTimesResponseMultiError is an error wrapping multiple validation
+// errors returned by GreetManyTimesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GreetManyTimesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetManyTimesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetManyTimesResponseMultiError) AllErrors() []error { return m }
+
+// GreetManyTimesResponseValidationError is the validation error returned by
+// GreetManyTimesResponse.Validate if the designated constraints aren't met.
+type GreetManyTimesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetManyTimesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetManyTimesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetManyTimesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetManyTimesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetManyTimesResponseValidationError) ErrorName() string {
+	return "GreetManyTimesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetManyTimesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetManyTimesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetManyTimesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetManyTimesResponseValidationError{}
+
+// Validate checks the field values on LongGreetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LongGreetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LongGreetRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// LongGreetRequestMultiError, or nil if none found.
+func (m *LongGreetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LongGreetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := LongGreetRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LongGreetRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LongGreetRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LongGreetRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := LongGreetRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+	if !_LongGreetRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := LongGreetRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Style_name[int32(m.GetStyle())]; !ok {
+		err := LongGreetRequestValidationError{
+			field:  "Style",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LongGreetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LongGreetRequestMultiError is an error wrapping multiple validation errors
+// returned by LongGreetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LongGreetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LongGreetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LongGreetRequestMultiError) AllErrors() []error { return m }
+
+// LongGreetRequestValidationError is the validation error returned by
+// LongGreetRequest.Validate if the designated constraints aren't met.
+type LongGreetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LongGreetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LongGreetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LongGreetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LongGreetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LongGreetRequestValidationError) ErrorName() string { return "LongGreetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LongGreetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLongGreetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LongGreetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LongGreetRequestValidationError{}
+
+var _LongGreetRequest_Locale_Pattern = regexp.MustCompile("^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$")
+
+// Validate checks the field values on LongGreetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LongGreetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LongGreetResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// LongGreetResponseMultiError, or nil if none found.
+func (m *LongGreetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LongGreetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	// no validation rules for Locale
+
+	// no validation rules for Count
+
+	if all {
+		switch v := interface{}(m.GetFirstReceived()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LongGreetResponseValidationError{
+					field:  "FirstReceived",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LongGreetResponseValidationError{
+					field:  "FirstReceived",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstReceived()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LongGreetResponseValidationError{
+				field:  "FirstReceived",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastReceived()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LongGreetResponseValidationError{
+					field:  "LastReceived",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LongGreetResponseValidationError{
+					field:  "LastReceived",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastReceived()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LongGreetResponseValidationError{
+				field:  "LastReceived",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Bytes
+
+	if len(errors) > 0 {
+		return LongGreetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LongGreetResponseMultiError is an error wrapping multiple validation errors
+// returned by LongGreetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LongGreetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LongGreetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LongGreetResponseMultiError) AllErrors() []error { return m }
+
+// LongGreetResponseValidationError is the validation error returned by
+// LongGreetResponse.Validate if the designated constraints aren't met.
+type LongGreetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LongGreetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LongGreetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LongGreetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LongGreetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LongGreetResponseValidationError) ErrorName() string {
+	return "LongGreetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LongGreetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLongGreetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LongGreetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LongGreetResponseValidationError{}
+
+// Validate checks the field values on GreetEveryoneRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GreetEveryoneRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetEveryoneRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GreetEveryoneRequestMultiError, or nil if none found.
+func (m *GreetEveryoneRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetEveryoneRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := GreetEveryoneRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetEveryoneRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetEveryoneRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetEveryoneRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := GreetEveryoneRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+	if !_GreetEveryoneRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := GreetEveryoneRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Style_name[int32(m.GetStyle())]; !ok {
+		err := GreetEveryoneRequestValidationError{
+			field:  "Style",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GreetEveryoneRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetEveryoneRequestMultiError is an error wrapping multiple validation
+// errors returned by GreetEveryoneRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GreetEveryoneRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetEveryoneRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetEveryoneRequestMultiError) AllErrors() []error { return m }
+
+// GreetEveryoneRequestValidationError is the validation error returned by
+// GreetEveryoneRequest.Validate if the designated constraints aren't met.
+type GreetEveryoneRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetEveryoneRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetEveryoneRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetEveryoneRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetEveryoneRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetEveryoneRequestValidationError) ErrorName() string {
+	return "GreetEveryoneRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetEveryoneRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetEveryoneRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetEveryoneRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetEveryoneRequestValidationError{}
+
+var _GreetEveryoneRequest_Locale_Pattern = regexp.MustCompile("^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$")
+
+// Validate checks the field values on GreetEveryoneResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GreetEveryoneResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetEveryoneResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// GreetEveryoneResponseMultiError, or nil if none found.
+func (m *GreetEveryoneResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetEveryoneResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	// no validation rules for Locale
+
+	// no validation rules for Event
+
+	// no validation rules for Room
+
+	if all {
+		switch v := interface{}(m.GetSender()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetEveryoneResponseValidationError{
+					field:  "Sender",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetEveryoneResponseValidationError{
+					field:  "Sender",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSender()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetEveryoneResponseValidationError{
+				field:  "Sender",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Sequence
+
+	// no validation rules for Replay
+
+	// no validation rules for Dropped
+
+	if len(errors) > 0 {
+		return GreetEveryoneResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetEveryoneResponseMultiError is an error wrapping multiple validation
+// errors returned by GreetEveryoneResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GreetEveryoneResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetEveryoneResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetEveryoneResponseMultiError) AllErrors() []error { return m }
+
+// GreetEveryoneResponseValidationError is the validation error returned by
+// GreetEveryoneResponse.Validate if the designated constraints aren't met.
+type GreetEveryoneResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetEveryoneResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetEveryoneResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetEveryoneResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetEveryoneResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetEveryoneResponseValidationError) ErrorName() string {
+	return "GreetEveryoneResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetEveryoneResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetEveryoneResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetEveryoneResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetEveryoneResponseValidationError{}
+
+// Validate checks the field values on GreetWithDeadLineRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GreetWithDeadLineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetWithDeadLineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreetWithDeadLineRequestMultiError, or nil if none found.
+func (m *GreetWithDeadLineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetWithDeadLineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := GreetWithDeadLineRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetWithDeadLineRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetWithDeadLineRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetWithDeadLineRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := GreetWithDeadLineRequestValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+	if !_GreetWithDeadLineRequest_Locale_Pattern.MatchString(m.GetLocale()) {
+		err := GreetWithDeadLineRequestValidationError{
+			field:  "Locale",
+			reason: "value does not match regex pattern \"^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Style_name[int32(m.GetStyle())]; !ok {
+		err := GreetWithDeadLineRequestValidationError{
+			field:  "Style",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GreetWithDeadLineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetWithDeadLineRequestMultiError is an error wrapping multiple validation
+// errors returned by GreetWithDeadLineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GreetWithDeadLineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetWithDeadLineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetWithDeadLineRequestMultiError) AllErrors() []error { return m }
+
+// GreetWithDeadLineRequestValidationError is the validation error returned by
+// GreetWithDeadLineRequest.Validate if the designated constraints aren't met.
+type GreetWithDeadLineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetWithDeadLineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetWithDeadLineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetWithDeadLineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetWithDeadLineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetWithDeadLineRequestValidationError) ErrorName() string {
+	return "GreetWithDeadLineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetWithDeadLineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetWithDeadLineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetWithDeadLineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetWithDeadLineRequestValidationError{}
+
+var _GreetWithDeadLineRequest_Locale_Pattern = regexp.MustCompile("^([A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*)?$")
+
+// Validate checks the field values on GreetWithDeadLineResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GreetWithDeadLineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetWithDeadLineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreetWithDeadLineResponseMultiError, or nil if none found.
+func (m *GreetWithDeadLineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetWithDeadLineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return GreetWithDeadLineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetWithDeadLineResponseMultiError is an error wrapping multiple validation
+// errors returned by GreetWithDeadLineResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GreetWithDeadLineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetWithDeadLineResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetWithDeadLineResponseMultiError) AllErrors() []error { return m }
+
+// GreetWithDeadLineResponseValidationError is the validation error returned by
+// GreetWithDeadLineResponse.Validate if the designated constraints aren't met.
+type GreetWithDeadLineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetWithDeadLineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetWithDeadLineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetWithDeadLineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetWithDeadLineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetWithDeadLineResponseValidationError) ErrorName() string {
+	return "GreetWithDeadLineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetWithDeadLineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetWithDeadLineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetWithDeadLineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetWithDeadLineResponseValidationError{}
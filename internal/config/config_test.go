@@ -0,0 +1,188 @@
+package config
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testMongo struct {
+	URI      string `config:"uri"`
+	Password string `config:"password,secret"`
+}
+
+type testSettings struct {
+	Addr    string        `config:"addr" usage:"listen address"`
+	Timeout time.Duration `config:"timeout"`
+	Tags    []string      `config:"tags"`
+	Mongo   testMongo     `config:"mongo"`
+}
+
+func defaults() testSettings {
+	return testSettings{Addr: "0.0.0.0:50051", Timeout: time.Second, Mongo: testMongo{URI: "mongodb://localhost:27017"}}
+}
+
+func load(t *testing.T, file, content string, env map[string]string, args ...string) (testSettings, *Effective, error) {
+	t.Helper()
+	if file != "" {
+		file = filepath.Join(t.TempDir(), file)
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		args = append([]string{"-config", file}, args...)
+	}
+	for k, v := range env {
+		t.Setenv(k, v)
+	}
+	cfg := defaults()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	e, err := LoadFlagSet(fs, args, "test", &cfg)
+	return cfg, e, err
+}
+
+func TestPrecedence(t *testing.T) {
+	tests := []struct {
+		name          string
+		file, content string
+		env           map[string]string
+		args          []string
+		want          testSettings
+	}{
+		{
+			name: "defaults",
+			want: defaults(),
+		},
+		{
+			name: "yaml file",
+			file: "c.yaml", content: "addr: :1\ntimeout: 5s\ntags: [a, b]\nmongo:\n  uri: mongodb://db:27017\n",
+			want: testSettings{Addr: ":1", Timeout: 5 * time.Second, Tags: []string{"a", "b"}, Mongo: testMongo{URI: "mongodb://db:27017"}},
+		},
+		{
+			name: "toml file with a flat key",
+			file: "c.toml", content: "addr = \":1\"\nmongo-uri = \"mongodb://db:27017\"\n",
+			want: testSettings{Addr: ":1", Timeout: time.Second, Mongo: testMongo{URI: "mongodb://db:27017"}},
+		},
+		{
+			name: "environment over file",
+			file: "c.yaml", content: "addr: :1\ntimeout: 5s\n",
+			env:  map[string]string{"TEST_ADDR": ":2", "TEST_MONGO_PASSWORD": "s3cret", "TEST_TAGS": "x,y"},
+			want: testSettings{Addr: ":2", Timeout: 5 * time.Second, Tags: []string{"x", "y"}, Mongo: testMongo{URI: "mongodb://localhost:27017", Password: "s3cret"}},
+		},
+		{
+			name: "flags over environment and file",
+			file: "c.yaml", content: "addr: :1\ntimeout: 5s\n",
+			env:  map[string]string{"TEST_ADDR": ":2", "TEST_TIMEOUT": "7s"},
+			args: []string{"-addr", ":3"},
+			want: testSettings{Addr: ":3", Timeout: 7 * time.Second, Mongo: testMongo{URI: "mongodb://localhost:27017"}},
+		},
+		{
+			name: "section flag over environment",
+			env:  map[string]string{"TEST_MONGO_URI": "mongodb://env:27017"},
+			args: []string{"-mongo-uri", "mongodb://flag:27017"},
+			want: testSettings{Addr: "0.0.0.0:50051", Timeout: time.Second, Mongo: testMongo{URI: "mongodb://flag:27017"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := load(t, tt.file, tt.content, tt.env, tt.args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Addr != tt.want.Addr || got.Timeout != tt.want.Timeout || got.Mongo != tt.want.Mongo || strings.Join(got.Tags, ",") != strings.Join(tt.want.Tags, ",") {
+				t.Errorf("loaded %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSources(t *testing.T) {
+	_, e, err := load(t, "c.yaml", "timeout: 5s\n", map[string]string{"TEST_MONGO_URI": "mongodb://env:27017"}, "-addr", ":3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	e.Print(&buf)
+	for _, want := range []string{"# -addr", ".yaml", "# $TEST_MONGO_URI", "# default"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Print lacks %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestProblems(t *testing.T) {
+	tests := []struct {
+		name          string
+		file, content string
+		env           map[string]string
+		args          []string
+		want          []string
+	}{
+		{name: "unknown file key", file: "c.yaml", content: "adr: :1\n", want: []string{"adr: unknown setting"}},
+		{name: "unknown format", file: "c.json", content: "{}", want: []string{"unknown format"}},
+		{name: "bad environment value", env: map[string]string{"TEST_TIMEOUT": "soon"}, want: []string{"timeout: $TEST_TIMEOUT"}},
+		{
+			name: "all reported at once",
+			file: "c.yaml", content: "timeout: soon\nport: 1\n",
+			env:  map[string]string{"TEST_TIMEOUT": "later"},
+			want: []string{"port: unknown setting", "timeout: ", "timeout: $TEST_TIMEOUT"},
+		},
+		{name: "bad flag", args: []string{"-timeout", "soon"}, want: []string{"-timeout"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := load(t, tt.file, tt.content, tt.env, tt.args...)
+			if err == nil {
+				t.Fatal("loaded")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q lacks %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRedaction(t *testing.T) {
+	_, e, err := load(t, "", "", map[string]string{
+		"TEST_MONGO_PASSWORD": "s3cret",
+		"TEST_MONGO_URI":      "mongodb://admin:hunter2@db:27017/blog",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	e.Print(&buf)
+	fields := e.Fields()
+	logged := map[string]interface{}{}
+	for i := 0; i < len(fields); i += 2 {
+		logged[fields[i].(string)] = fields[i+1]
+	}
+
+	tests := []struct {
+		key, want string
+	}{
+		{"mongo-password", "<redacted>"},
+		{"mongo-uri", "mongodb://admin:redacted@db:27017/blog"},
+		{"addr", "0.0.0.0:50051"},
+	}
+	for _, tt := range tests {
+		if logged[tt.key] != tt.want {
+			t.Errorf("Fields()[%s] = %v, want %q", tt.key, logged[tt.key], tt.want)
+		}
+		if !strings.Contains(buf.String(), tt.want) {
+			t.Errorf("Print lacks %q:\n%s", tt.want, buf.String())
+		}
+	}
+	for _, secret := range []string{"s3cret", "hunter2"} {
+		if strings.Contains(buf.String(), secret) {
+			t.Errorf("Print shows %q:\n%s", secret, buf.String())
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// readFile decodes a YAML or TOML file into settings, the keys of nested
+// sections joined by dashes.
+func readFile(name string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	switch ext := filepath.Ext(name); ext {
+	case ".yaml", ".yml":
+		var raw map[interface{}]interface{}
+		if err := yaml.UnmarshalStrict(data, &raw); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		doc = stringKeys(raw)
+	case ".toml":
+		if _, err := toml.Decode(string(data), &doc); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	default:
+		return nil, fmt.Errorf("%s: unknown format %q, use .yaml, .yml or .toml", name, ext)
+	}
+	values := map[string]interface{}{}
+	flatten(values, "", doc)
+	return values, nil
+}
+
+// stringKeys converts the maps decoded from YAML to the maps of TOML.
+func stringKeys(m map[interface{}]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[fmt.Sprint(k)] = yamlValue(v)
+	}
+	return out
+}
+
+func yamlValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		return stringKeys(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = yamlValue(v[i])
+		}
+	}
+	return v
+}
+
+func flatten(values map[string]interface{}, prefix string, doc map[string]interface{}) {
+	for k, v := range doc {
+		key := k
+		if prefix != "" {
+			key = prefix + "-" + k
+		}
+		if section, ok := v.(map[string]interface{}); ok {
+			flatten(values, key, section)
+			continue
+		}
+		values[key] = v
+	}
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Problems collects what is wrong with a configuration, to report it all
+// at once rather than one error per start.
+type Problems []string
+
+// Add records a problem with the setting key.
+func (p *Problems) Add(key, format string, args ...interface{}) {
+	*p = append(*p, key+": "+fmt.Sprintf(format, args...))
+}
+
+// Err returns the problems as one error, nil if there are none.
+func (p Problems) Err() error {
+	if len(p) == 0 {
+		return nil
+	}
+	return errors.New("invalid configuration:\n  " + strings.Join(p, "\n  "))
+}
+
+// Addr checks a host:port listen or dial address. Empty addresses are
+// accepted when optional, to disable a listener.
+func (p *Problems) Addr(key, addr string, optional bool) {
+	if addr == "" {
+		if !optional {
+			p.Add(key, "an address is required")
+		}
+		return
+	}
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		p.Add(key, "%q is not host:port", addr)
+		return
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 0 || n > 65535 {
+		p.Add(key, "%q has no valid port", addr)
+	}
+}
+
+// Distinct checks that the given listen addresses, by setting, do not
+// share a port.
+func (p *Problems) Distinct(addrs map[string]string) {
+	seen := map[string]string{}
+	for _, key := range sortedKeys(addrs) {
+		addr := addrs[key]
+		if addr == "" {
+			continue
+		}
+		_, port, err := net.SplitHostPort(addr)
+		if err != nil || port == "0" {
+			continue
+		}
+		if other, ok := seen[port]; ok {
+			p.Add(key, "port %s is also used by %s", port, other)
+			continue
+		}
+		seen[port] = key
+	}
+}
+
+// File checks that name is a readable file, when set or required.
+func (p *Problems) File(key, name string, required bool) {
+	if name == "" {
+		if required {
+			p.Add(key, "a file is required")
+		}
+		return
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		p.Add(key, "%v", err)
+		return
+	}
+	f.Close()
+}
+
+// Min checks that a number is at least min.
+func (p *Problems) Min(key string, n, min int) {
+	if n < min {
+		p.Add(key, "%d is less than %d", n, min)
+	}
+}
+
+// NonNegative checks that a duration is not negative.
+func (p *Problems) NonNegative(key string, d time.Duration) {
+	if d < 0 {
+		p.Add(key, "%v is negative", d)
+	}
+}
+
+// OneOf checks that v is one of values.
+func (p *Problems) OneOf(key, v string, values ...string) {
+	for _, ok := range values {
+		if v == ok {
+			return
+		}
+	}
+	p.Add(key, "%q is not one of %s", v, strings.Join(values, ", "))
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
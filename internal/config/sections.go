@@ -0,0 +1,232 @@
+package config
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/deadline"
+	"github.com/dipjyotimetia/gogrpc/internal/healthcheck"
+	"github.com/dipjyotimetia/gogrpc/internal/lifecycle"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// ServerTLS are the TLS settings of a server, inlined in its settings.
+type ServerTLS struct {
+	Cert           string        `config:"tls-cert" usage:"PEM certificate of the server; serves TLS when set, the gateway included"`
+	Key            string        `config:"tls-key" usage:"PEM key of the server"`
+	ClientCA       string        `config:"client-ca" usage:"PEM CA bundle client certificates must be signed by; enables mutual TLS"`
+	ReloadInterval time.Duration `config:"tls-reload-interval" usage:"how often certificate files are checked for changes, 0 to never reload"`
+}
+
+// Check adds the problems of the settings to p. Servers always serving
+// TLS require the certificate.
+func (t ServerTLS) Check(p *Problems, required bool) {
+	p.File("tls-cert", t.Cert, required)
+	p.File("tls-key", t.Key, required || t.Cert != "")
+	p.File("client-ca", t.ClientCA, false)
+	if t.Cert == "" && t.Key != "" {
+		p.Add("tls-key", "needs tls-cert")
+	}
+	if t.Cert == "" && t.ClientCA != "" {
+		p.Add("client-ca", "needs tls-cert and tls-key")
+	}
+	p.NonNegative("tls-reload-interval", t.ReloadInterval)
+}
+
+// Enabled tells whether the server serves TLS.
+func (t ServerTLS) Enabled() bool {
+	return t.Cert != ""
+}
+
+// ClientTLS are the TLS settings of a client, inlined in its settings.
+type ClientTLS struct {
+	Enabled    bool   `config:"tls" usage:"connect with TLS"`
+	CACert     string `config:"ca-cert" usage:"CA certificate used to verify the server with -tls, empty for the system roots"`
+	Cert       string `config:"cert" usage:"PEM client certificate presented to servers requiring mutual TLS"`
+	Key        string `config:"key" usage:"PEM key of -cert"`
+	ServerName string `config:"server-name" usage:"override the server name checked against the certificate"`
+}
+
+// Check adds the problems of the settings to p.
+func (t ClientTLS) Check(p *Problems) {
+	if !t.Enabled {
+		return
+	}
+	p.File("ca-cert", t.CACert, false)
+	p.File("cert", t.Cert, t.Key != "")
+	p.File("key", t.Key, t.Cert != "")
+}
+
+// DialOption returns the transport credentials of the settings.
+func (t ClientTLS) DialOption() (grpc.DialOption, error) {
+	if !t.Enabled {
+		return grpc.WithInsecure(), nil
+	}
+	cfg, err := mtls.ClientConfig(t.CACert, t.Cert, t.Key, t.ServerName)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(cfg)), nil
+}
+
+// Deadlines are the deadline policy settings of a server, inlined in its
+// settings.
+type Deadlines struct {
+	Default        time.Duration `config:"default-deadline" usage:"deadline of unary calls sent without one, 0 for none"`
+	Minimum        time.Duration `config:"min-deadline" usage:"least time left a call needs to be served"`
+	MethodMinimums string        `config:"method-min-deadlines" usage:"per method minimums, as /pkg.Service/Method=duration,..."`
+}
+
+// Check adds the problems of the settings to p.
+func (d Deadlines) Check(p *Problems) {
+	p.NonNegative("default-deadline", d.Default)
+	p.NonNegative("min-deadline", d.Minimum)
+	if _, err := deadline.ParseMinimums(d.MethodMinimums); err != nil {
+		p.Add("method-min-deadlines", "%v", err)
+	}
+}
+
+// Policy returns the deadline policy of the settings.
+func (d Deadlines) Policy() (deadline.Policy, error) {
+	minimums, err := deadline.ParseMinimums(d.MethodMinimums)
+	if err != nil {
+		return deadline.Policy{}, err
+	}
+	return deadline.Policy{Default: d.Default, Minimum: d.Minimum, Minimums: minimums}, nil
+}
+
+// Shutdown are the graceful shutdown settings of a server, inlined in its
+// settings.
+type Shutdown struct {
+	Drain   time.Duration `config:"drain-period" usage:"how long the health checks report NOT_SERVING before the server stops accepting calls"`
+	Timeout time.Duration `config:"shutdown-timeout" usage:"how long running calls get to finish before they are cancelled"`
+}
+
+// DefaultShutdown returns the default shutdown settings, fitting the 30s
+// grace period of container platforms.
+func DefaultShutdown() Shutdown {
+	return Shutdown{Drain: 5 * time.Second, Timeout: 20 * time.Second}
+}
+
+// Check adds the problems of the settings to p.
+func (s Shutdown) Check(p *Problems) {
+	p.NonNegative("drain-period", s.Drain)
+	if s.Timeout <= 0 {
+		p.Add("shutdown-timeout", "must be positive")
+	}
+}
+
+// Lifecycle returns the lifecycle of the settings, logging to log.
+func (s Shutdown) Lifecycle(log *logging.Logger) *lifecycle.Lifecycle {
+	return lifecycle.New(s.Drain, s.Timeout, log)
+}
+
+// Health are the health check settings of a server, inlined in its
+// settings.
+type Health struct {
+	Interval time.Duration `config:"health-interval" usage:"how often the dependencies of the services are checked"`
+	Timeout  time.Duration `config:"health-timeout" usage:"how long a dependency check may take before it fails"`
+}
+
+// DefaultHealth returns the default health check settings.
+func DefaultHealth() Health {
+	return Health{Interval: 10 * time.Second, Timeout: 5 * time.Second}
+}
+
+// Check adds the problems of the settings to p.
+func (h Health) Check(p *Problems) {
+	if h.Interval <= 0 {
+		p.Add("health-interval", "must be positive")
+	}
+	if h.Timeout <= 0 {
+		p.Add("health-timeout", "must be positive")
+	}
+}
+
+// Monitor returns the health monitor of the settings, logging to log.
+func (h Health) Monitor(log *logging.Logger) *healthcheck.Monitor {
+	return healthcheck.New(h.Interval, h.Timeout, log)
+}
+
+// Tracing are the tracing settings of a process, inlined in its settings.
+type Tracing struct {
+	Exporter    string  `config:"trace-exporter" usage:"where spans are sent: none, otlp or file"`
+	Endpoint    string  `config:"otlp-endpoint" usage:"OTLP/HTTP collector the otlp exporter posts spans to"`
+	File        string  `config:"trace-file" usage:"file the file exporter appends spans to, as OTLP JSON lines"`
+	SampleRatio float64 `config:"trace-sample-ratio" usage:"share of the new traces recorded, from 0 to 1"`
+}
+
+// DefaultTracing returns the default tracing settings, with tracing off.
+func DefaultTracing() Tracing {
+	return Tracing{Exporter: "none", Endpoint: "http://localhost:4318", File: "traces.jsonl", SampleRatio: 1}
+}
+
+// Check adds the problems of the settings to p.
+func (t Tracing) Check(p *Problems) {
+	p.OneOf("trace-exporter", t.Exporter, "none", "otlp", "file")
+	switch t.Exporter {
+	case "otlp":
+		if u, err := url.Parse(t.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			p.Add("otlp-endpoint", "%q is not an http:// or https:// URL", t.Endpoint)
+		}
+	case "file":
+		if t.File == "" {
+			p.Add("trace-file", "a file is required by the file exporter")
+		}
+	}
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		p.Add("trace-sample-ratio", "%v is not between 0 and 1", t.SampleRatio)
+	}
+}
+
+// Tracer returns the tracer of the service, nil when tracing is off.
+func (t Tracing) Tracer(service string, log tracing.Logger) (*tracing.Tracer, error) {
+	var e tracing.Exporter
+	switch t.Exporter {
+	case "otlp":
+		e = tracing.NewOTLPExporter(t.Endpoint)
+	case "file":
+		f, err := tracing.NewFileExporter(t.File)
+		if err != nil {
+			return nil, err
+		}
+		e = f
+	default:
+		return nil, nil
+	}
+	return tracing.New(service, e, t.SampleRatio, log), nil
+}
+
+// Logging are the logging settings of a server, inlined in its settings.
+type Logging struct {
+	Level    string `config:"log-level" usage:"least severe events logged: debug, info, warn or error"`
+	Payloads string `config:"log-payloads" usage:"comma separated methods whose payloads are logged, like greet.GreetService/Greet, calc.SumService/* or *"`
+}
+
+// DefaultLogging returns the default logging settings, without payloads.
+func DefaultLogging() Logging {
+	return Logging{Level: "info"}
+}
+
+// Check adds the problems of the settings to p.
+func (l Logging) Check(p *Problems) {
+	p.OneOf("log-level", l.Level, "debug", "info", "warn", "error")
+}
+
+// Logger returns the logger of the settings, writing to stdout, and the
+// methods logging payloads.
+func (l Logging) Logger() (*logging.Logger, *logging.Payloads) {
+	level, _ := logging.ParseLevel(l.Level)
+	logger := logging.Default()
+	logger.SetLevel(level)
+	var payloads []string
+	if l.Payloads != "" {
+		payloads = strings.Split(l.Payloads, ",")
+	}
+	return logger, logging.NewPayloads(payloads...)
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// setting is a field of a settings struct.
+type setting struct {
+	key    string
+	usage  string
+	secret bool
+	field  reflect.Value
+	// source is where the value came from: a flag, a variable or a file
+	source string
+	flag   bool
+}
+
+func supported(t reflect.Type) bool {
+	if t == durationType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint32, reflect.Uint64, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.String
+	}
+	return false
+}
+
+// set parses raw into the setting. Lists are comma separated.
+func (s *setting) set(raw string) error {
+	f := s.field
+	if f.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration such as 30s or 5m", raw)
+		}
+		f.SetInt(int64(d))
+		return nil
+	}
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", raw)
+		}
+		f.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 0, f.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", raw)
+		}
+		f.SetInt(n)
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 0, f.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%q is not a positive integer", raw)
+		}
+		f.SetUint(n)
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", raw)
+		}
+		f.SetFloat(n)
+	case reflect.Slice:
+		var list []string
+		for _, v := range strings.Split(raw, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				list = append(list, v)
+			}
+		}
+		f.Set(reflect.ValueOf(list))
+	}
+	return nil
+}
+
+// setFile sets a value decoded from a file: a scalar, or a list for list
+// settings.
+func (s *setting) setFile(v interface{}) error {
+	if items, ok := v.([]interface{}); ok {
+		if s.field.Kind() != reflect.Slice {
+			return fmt.Errorf("a list is given, a single value is expected")
+		}
+		list := make([]string, 0, len(items))
+		for _, item := range items {
+			list = append(list, fmt.Sprint(item))
+		}
+		s.field.Set(reflect.ValueOf(list))
+		return nil
+	}
+	if _, ok := v.(map[string]interface{}); ok {
+		return fmt.Errorf("a section is given, a value is expected")
+	}
+	return s.set(fmt.Sprint(v))
+}
+
+func (s *setting) String() string {
+	f := s.field
+	if f.Type() == durationType {
+		return time.Duration(f.Int()).String()
+	}
+	if f.Kind() == reflect.Slice {
+		list := make([]string, f.Len())
+		for i := range list {
+			list[i] = f.Index(i).String()
+		}
+		return strings.Join(list, ",")
+	}
+	return fmt.Sprint(f.Interface())
+}
@@ -0,0 +1,188 @@
+// Package deadline budgets the time calls may take, so that timeouts
+// shrink along a chain of calls instead of cascading.
+//
+// On the server side, calls arriving without a deadline get a default one,
+// and calls whose deadline leaves less than the minimum of their method
+// are rejected at once with DEADLINE_EXCEEDED, before doing work whose
+// result nobody will wait for. On the client side, the deadline of the
+// context is moved a safety margin earlier before calling downstream, so
+// the caller still has time to handle a downstream timeout before its own
+// deadline passes.
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// ReasonTooShort is the ErrorInfo reason of calls rejected for their
+// deadline.
+const ReasonTooShort = "DEADLINE_TOO_SHORT"
+
+// Policy is the deadline policy of a server.
+type Policy struct {
+	// Default is the deadline of unary calls arriving without one, 0 for
+	// none.
+	Default time.Duration
+	// StreamDefault is the deadline of streams arriving without one,
+	// usually 0: streams such as chat rooms are meant to last.
+	StreamDefault time.Duration
+	// Minimum is the least time left a call needs to be served.
+	Minimum time.Duration
+	// Minimums overrides Minimum by full method name, such as
+	// "/greet.GreetService/GreetWithDeadLine".
+	Minimums map[string]time.Duration
+}
+
+// ParseMinimums parses per method minimums written as
+// "/pkg.Service/Method=2s,/pkg.Service/Other=500ms".
+func ParseMinimums(s string) (map[string]time.Duration, error) {
+	m := map[string]time.Duration{}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		i := strings.LastIndexByte(item, '=')
+		if i < 0 || !strings.HasPrefix(item, "/") {
+			return nil, fmt.Errorf("deadline: %q is not /service/method=duration", item)
+		}
+		d, err := time.ParseDuration(item[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("deadline: %s: %w", item[:i], err)
+		}
+		m[item[:i]] = d
+	}
+	return m, nil
+}
+
+// apply gives ctx the default deadline when it has none, or checks the
+// deadline the caller sent against the minimum of the method.
+func (p Policy) apply(ctx context.Context, method string, def time.Duration) (context.Context, context.CancelFunc, error) {
+	d, ok := ctx.Deadline()
+	if !ok {
+		if def > 0 {
+			ctx, cancel := context.WithTimeout(ctx, def)
+			return ctx, cancel, nil
+		}
+		return ctx, func() {}, nil
+	}
+	min, ok := p.Minimums[method]
+	if !ok {
+		min = p.Minimum
+	}
+	if left := time.Until(d); left < min {
+		return nil, nil, &rpcerr.Error{
+			Code:     codes.DeadlineExceeded,
+			Reason:   ReasonTooShort,
+			Message:  fmt.Sprintf("deadline too short: %s needs at least %v", method, min),
+			Metadata: map[string]string{"minimum": min.String(), "left": left.Round(time.Millisecond).String()},
+		}
+	}
+	return ctx, func() {}, nil
+}
+
+// UnaryServerInterceptor applies p to unary calls. It is sixth in the
+// chain, after mtls and before validation.
+func UnaryServerInterceptor(p Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel, err := p.apply(ctx, info.FullMethod, p.Default)
+		if err != nil {
+			return nil, err
+		}
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor applies p to streams.
+func StreamServerInterceptor(p Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel, err := p.apply(ss.Context(), info.FullMethod, p.StreamDefault)
+		if err != nil {
+			return err
+		}
+		defer cancel()
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// Budget moves the deadline of ctx margin earlier, for a downstream call.
+// It fails with DEADLINE_EXCEEDED when no time would be left; contexts
+// without a deadline are returned as they are.
+func Budget(ctx context.Context, margin time.Duration) (context.Context, context.CancelFunc, error) {
+	d, ok := ctx.Deadline()
+	if !ok {
+		return ctx, func() {}, nil
+	}
+	d = d.Add(-margin)
+	if time.Until(d) <= 0 {
+		return nil, nil, &rpcerr.Error{
+			Code:     codes.DeadlineExceeded,
+			Reason:   rpcerr.ReasonDeadlineExceeded,
+			Message:  "no time left for the call",
+			Metadata: map[string]string{"margin": margin.String()},
+		}
+	}
+	ctx, cancel := context.WithDeadline(ctx, d)
+	return ctx, cancel, nil
+}
+
+// UnaryClientInterceptor calls with the budget left by the deadline of the
+// context, minus margin. Handlers calling other services pass their own
+// context, so the budget shrinks at each hop.
+func UnaryClientInterceptor(margin time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, cancel, err := Budget(ctx, margin)
+		if err != nil {
+			return err
+		}
+		defer cancel()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is the streaming counterpart of
+// UnaryClientInterceptor. The budget is released when the stream ends.
+func StreamClientInterceptor(margin time.Duration) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, cancel, err := Budget(ctx, margin)
+		if err != nil {
+			return nil, err
+		}
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		return &clientStream{ClientStream: cs, cancel: cancel}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.cancel()
+	}
+	return err
+}
@@ -0,0 +1,186 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const method = "/greet.GreetService/Greet"
+
+// left returns the time left before the deadline of ctx, 0 for none.
+func left(ctx context.Context) time.Duration {
+	d, ok := ctx.Deadline()
+	if !ok {
+		return 0
+	}
+	return time.Until(d)
+}
+
+func TestServerPolicy(t *testing.T) {
+	policy := Policy{
+		Default:       5 * time.Second,
+		StreamDefault: 0,
+		Minimum:       100 * time.Millisecond,
+		Minimums:      map[string]time.Duration{method: 2 * time.Second},
+	}
+	tests := []struct {
+		name    string
+		method  string
+		stream  bool
+		timeout time.Duration // sent by the client, 0 for none
+		want    time.Duration // deadline left in the handler, 0 for none
+		reject  bool
+	}{
+		{name: "unary default", method: "/calc.CalcService/Sum", want: 5 * time.Second},
+		{name: "client deadline kept", method: "/calc.CalcService/Sum", timeout: time.Second, want: time.Second},
+		{name: "below the minimum", method: "/calc.CalcService/Sum", timeout: 50 * time.Millisecond, reject: true},
+		{name: "below the method minimum", method: method, timeout: time.Second, reject: true},
+		{name: "above the method minimum", method: method, timeout: 3 * time.Second, want: 3 * time.Second},
+		{name: "streams without a default", method: "/greet.GreetService/GreetEveryone", stream: true},
+		{name: "streams checked too", method: "/greet.GreetService/GreetEveryone", stream: true, timeout: 50 * time.Millisecond, reject: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			var got time.Duration
+			called := false
+			var err error
+			if tt.stream {
+				err = StreamServerInterceptor(policy)(nil, &serverStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: tt.method}, func(_ interface{}, ss grpc.ServerStream) error {
+					called, got = true, left(ss.Context())
+					return nil
+				})
+			} else {
+				_, err = UnaryServerInterceptor(policy)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+					called, got = true, left(ctx)
+					return nil, nil
+				})
+			}
+
+			if tt.reject {
+				var e *rpcerr.Error
+				if !errors.As(err, &e) || e.Code != codes.DeadlineExceeded || e.Reason != ReasonTooShort {
+					t.Fatalf("error = %v, want DEADLINE_EXCEEDED with reason %s", err, ReasonTooShort)
+				}
+				if called {
+					t.Error("handler called")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == 0 && got != 0 {
+				t.Errorf("deadline %v left, want none", got)
+			}
+			if tt.want != 0 && (got > tt.want || got < tt.want-100*time.Millisecond) {
+				t.Errorf("deadline %v left, want about %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBudget(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		margin  time.Duration
+		want    time.Duration
+		reject  bool
+	}{
+		{name: "no deadline", margin: time.Second},
+		{name: "margin taken off", timeout: 3 * time.Second, margin: time.Second, want: 2 * time.Second},
+		{name: "nothing left", timeout: 500 * time.Millisecond, margin: time.Second, reject: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			var got time.Duration
+			err := UnaryClientInterceptor(tt.margin)(ctx, method, nil, nil, nil, func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				got = left(ctx)
+				return nil
+			})
+			if tt.reject {
+				var e *rpcerr.Error
+				if !errors.As(err, &e) || e.Code != codes.DeadlineExceeded {
+					t.Fatalf("error = %v, want DEADLINE_EXCEEDED", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == 0 && got != 0 {
+				t.Errorf("deadline %v left, want none", got)
+			}
+			if tt.want != 0 && (got > tt.want || got < tt.want-100*time.Millisecond) {
+				t.Errorf("deadline %v left, want about %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBudgetShrinksAtEachHop(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	// a handler calling a service that calls another one
+	for hop := 1; hop <= 2; hop++ {
+		next, cancel, err := Budget(ctx, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer cancel()
+		if got, want := left(next), time.Duration(3-hop)*time.Second; got > want || got < want-100*time.Millisecond {
+			t.Errorf("hop %d: %v left, want about %v", hop, got, want)
+		}
+		ctx = next
+	}
+	if _, _, err := Budget(ctx, time.Second); err == nil {
+		t.Error("third hop budgeted, want DEADLINE_EXCEEDED")
+	}
+}
+
+func TestParseMinimums(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    map[string]time.Duration
+		wantErr bool
+	}{
+		{in: "", want: map[string]time.Duration{}},
+		{in: method + "=2s, /calc.CalcService/Sum=500ms", want: map[string]time.Duration{method: 2 * time.Second, "/calc.CalcService/Sum": 500 * time.Millisecond}},
+		{in: "greet.GreetService/Greet=2s", wantErr: true},
+		{in: method, wantErr: true},
+		{in: method + "=soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseMinimums(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseMinimums(%q) error = %v, want an error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("ParseMinimums(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+		for k, v := range tt.want {
+			if got[k] != v {
+				t.Errorf("ParseMinimums(%q)[%s] = %v, want %v", tt.in, k, got[k], v)
+			}
+		}
+	}
+}
@@ -0,0 +1,364 @@
+// Package gateway serves gRPC services as HTTP/JSON, following the
+// google.api.http annotations in the protos.
+//
+// Unary methods answer with one JSON object. Streaming responses are written
+// as newline-delimited JSON (application/x-ndjson), one message per line;
+// an error after the first message is reported as a final {"error": ...}
+// line. Client-streaming methods read their requests the same way from the
+// body. HTTP/1.1 handlers cannot read the body once the response has
+// started, so the whole request stream is read before the first response
+// is written.
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/dipjyotimetia/gogrpc/internal/inprocess"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails" // resolve details in error bodies
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// MetadataHeaderPrefix marks HTTP headers forwarded as gRPC metadata, with
+// the prefix removed. Authorization and Accept-Language are always forwarded.
+const MetadataHeaderPrefix = "Grpc-Metadata-"
+
+var forwardedHeaders = []string{"Authorization", "Accept-Language", "Traceparent", "Tracestate", "X-Request-Id"}
+
+// returnedHeaders are the response headers of the handlers copied to the
+// HTTP response.
+var returnedHeaders = []string{"X-Request-Id"}
+
+var (
+	marshaler   = protojson.MarshalOptions{UseProtoNames: true}
+	unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// Gateway is an http.Handler translating requests into calls on a gRPC
+// connection, usually one to an inprocess.Server.
+type Gateway struct {
+	conn   grpc.ClientConnInterface
+	routes []*route
+}
+
+// New builds the routes of the named services from their annotations.
+// Methods without a google.api.http option are not exposed.
+func New(conn grpc.ClientConnInterface, services ...string) (*Gateway, error) {
+	g := &Gateway{conn: conn}
+	for _, name := range services {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("gateway: service %s: %w", name, err)
+		}
+		sd, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("gateway: %s is not a service", name)
+		}
+		methods := sd.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			md := methods.Get(i)
+			rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+			for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+				rt, err := newRoute(md, r)
+				if err != nil {
+					return nil, fmt.Errorf("gateway: %s: %w", md.FullName(), err)
+				}
+				g.routes = append(g.routes, rt)
+			}
+		}
+	}
+	return g, nil
+}
+
+// InProcess registers services through register on an inprocess.Server
+// built with opts, and returns a Gateway calling them. Pass the interceptor
+// options of the public gRPC server so both paths behave the same. stop
+// shuts the in-process server down.
+func InProcess(register func(*grpc.Server), opts ...grpc.ServerOption) (g *Gateway, stop func(), err error) {
+	s := inprocess.NewServer(opts...)
+	register(s.Server)
+	s.Start()
+	conn, err := s.Dial(context.Background())
+	if err != nil {
+		s.Stop()
+		return nil, nil, err
+	}
+	stop = func() {
+		conn.Close()
+		s.Stop()
+	}
+	if g, err = New(conn, s.ServiceNames()...); err != nil {
+		stop()
+		return nil, nil, err
+	}
+	return g, stop, nil
+}
+
+// ListenAndServe serves h on addr, over TLS when tlsConfig is set.
+func ListenAndServe(addr string, h http.Handler, tlsConfig *tls.Config) error {
+	return Serve(&http.Server{Addr: addr, Handler: h, TLSConfig: tlsConfig})
+}
+
+// Serve serves srv on its address, over TLS when its TLSConfig is set. It
+// returns nil once srv is shut down.
+func Serve(srv *http.Server) error {
+	var err error
+	if srv.TLSConfig != nil {
+		err = srv.ListenAndServeTLS("", "")
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := splitPath(r.URL.EscapedPath())
+	pathMatched := false
+	for _, rt := range g.routes {
+		vars, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.httpMethod != r.Method {
+			continue
+		}
+		g.serve(w, r, rt, vars)
+		return
+	}
+	if pathMatched {
+		writeError(w, status.Error(codes.Unimplemented, fmt.Sprintf("method %s not allowed on %s", r.Method, r.URL.Path)), http.StatusMethodNotAllowed)
+		return
+	}
+	writeError(w, status.Error(codes.NotFound, fmt.Sprintf("no route for %s", r.URL.Path)), http.StatusNotFound)
+}
+
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request, rt *route, vars map[string]string) {
+	ctx := metadata.NewOutgoingContext(r.Context(), incomingMetadata(r))
+	md := rt.method
+	switch {
+	case !md.IsStreamingClient() && !md.IsStreamingServer():
+		req, err := rt.request(r, vars)
+		if err != nil {
+			writeError(w, err, 0)
+			return
+		}
+		res := newMessage(md.Output())
+		var header metadata.MD
+		err = g.conn.Invoke(ctx, rt.fullMethod, req, res, grpc.Header(&header))
+		returnHeaders(w, header)
+		if err != nil {
+			writeError(w, err, 0)
+			return
+		}
+		writeJSON(w, rt.responseBody(res))
+	default:
+		g.serveStream(ctx, w, r, rt, vars)
+	}
+}
+
+func (g *Gateway) serveStream(ctx context.Context, w http.ResponseWriter, r *http.Request, rt *route, vars map[string]string) {
+	md := rt.method
+	var reqs []proto.Message
+	if md.IsStreamingClient() {
+		var err error
+		if reqs, err = rt.streamRequests(r, vars); err != nil {
+			writeError(w, err, 0)
+			return
+		}
+	} else {
+		req, err := rt.request(r, vars)
+		if err != nil {
+			writeError(w, err, 0)
+			return
+		}
+		reqs = []proto.Message{req}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	desc := &grpc.StreamDesc{StreamName: string(md.Name()), ServerStreams: md.IsStreamingServer(), ClientStreams: md.IsStreamingClient()}
+	stream, err := g.conn.NewStream(ctx, desc, rt.fullMethod)
+	if err != nil {
+		writeError(w, err, 0)
+		return
+	}
+	go func() {
+		for _, req := range reqs {
+			if err := stream.SendMsg(req); err != nil {
+				// the handler has returned, RecvMsg reports why
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+	// the headers come with the first response or the error
+	if header, err := stream.Header(); err == nil {
+		returnHeaders(w, header)
+	}
+
+	if !md.IsStreamingServer() {
+		res := newMessage(md.Output())
+		if err := stream.RecvMsg(res); err != nil {
+			writeError(w, err, 0)
+			return
+		}
+		writeJSON(w, rt.responseBody(res))
+		return
+	}
+
+	started := false
+	flusher, _ := w.(http.Flusher)
+	for {
+		res := newMessage(md.Output())
+		err := stream.RecvMsg(res)
+		if err == io.EOF {
+			if !started {
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				w.WriteHeader(http.StatusOK)
+			}
+			return
+		}
+		if err != nil {
+			if !started {
+				writeError(w, err, 0)
+				return
+			}
+			line, _ := json.Marshal(map[string]json.RawMessage{"error": statusJSON(status.Convert(err))})
+			w.Write(append(line, '\n'))
+			return
+		}
+		if !started {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		data, err := marshaler.Marshal(rt.responseBody(res))
+		if err != nil {
+			return
+		}
+		if _, err := w.Write(append(data, '\n')); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+func newMessage(md protoreflect.MessageDescriptor) proto.Message {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		// the generated packages register every message they define
+		panic(fmt.Sprintf("gateway: message %s is not registered", md.FullName()))
+	}
+	return mt.New().Interface()
+}
+
+// returnHeaders copies the returned headers of the handler to w.
+func returnHeaders(w http.ResponseWriter, header metadata.MD) {
+	for _, key := range returnedHeaders {
+		for _, v := range header.Get(key) {
+			w.Header().Add(key, v)
+		}
+	}
+}
+
+// incomingMetadata picks the HTTP headers forwarded to the handlers.
+func incomingMetadata(r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		if strings.HasPrefix(key, MetadataHeaderPrefix) {
+			md.Append(strings.TrimPrefix(key, MetadataHeaderPrefix), values...)
+		}
+	}
+	for _, key := range forwardedHeaders {
+		if values := r.Header.Values(key); len(values) > 0 {
+			md.Append(key, values...)
+		}
+	}
+	mtls.Forward(md, r.TLS)
+	return md
+}
+
+func writeJSON(w http.ResponseWriter, m proto.Message) {
+	data, err := marshaler.Marshal(m)
+	if err != nil {
+		writeError(w, status.Error(codes.Internal, err.Error()), 0)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// writeError writes err as a JSON google.rpc.Status. The HTTP status is
+// derived from the gRPC code unless httpStatus is set.
+func writeError(w http.ResponseWriter, err error, httpStatus int) {
+	st := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = HTTPStatusFromCode(st.Code())
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(httpStatus)
+	w.Write(statusJSON(st))
+}
+
+func statusJSON(st *status.Status) []byte {
+	data, err := marshaler.Marshal(st.Proto())
+	if err != nil {
+		data, _ = json.Marshal(map[string]interface{}{"code": st.Code(), "message": st.Message()})
+	}
+	return data
+}
+
+// HTTPStatusFromCode maps a gRPC code to the HTTP status documented in
+// google/rpc/code.proto.
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,306 @@
+package gateway
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxBodyBytes bounds the request bodies read by the gateway.
+const maxBodyBytes = 4 << 20
+
+// segment is one part of a path template: a literal, a "*" wildcard or a
+// variable bound to a request field.
+type segment struct {
+	literal  string
+	variable string // field path, e.g. "blog.id"
+	wildcard bool
+}
+
+type route struct {
+	method        protoreflect.MethodDescriptor
+	fullMethod    string
+	httpMethod    string
+	segments      []segment
+	body          string
+	responseField protoreflect.FieldDescriptor
+}
+
+func newRoute(md protoreflect.MethodDescriptor, rule *annotations.HttpRule) (*route, error) {
+	rt := &route{
+		method:     md,
+		fullMethod: fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name()),
+		body:       rule.GetBody(),
+	}
+	var template string
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		rt.httpMethod, template = http.MethodGet, p.Get
+	case *annotations.HttpRule_Put:
+		rt.httpMethod, template = http.MethodPut, p.Put
+	case *annotations.HttpRule_Post:
+		rt.httpMethod, template = http.MethodPost, p.Post
+	case *annotations.HttpRule_Delete:
+		rt.httpMethod, template = http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		rt.httpMethod, template = http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Custom:
+		rt.httpMethod, template = p.Custom.GetKind(), p.Custom.GetPath()
+	default:
+		return nil, fmt.Errorf("http rule without a pattern")
+	}
+
+	if !strings.HasPrefix(template, "/") {
+		return nil, fmt.Errorf("path template %q must start with /", template)
+	}
+	for _, part := range splitPath(template) {
+		switch {
+		case part == "*":
+			rt.segments = append(rt.segments, segment{wildcard: true})
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+			v := strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}")
+			v = strings.TrimSuffix(v, "=*")
+			if strings.ContainsAny(v, "=*{}") {
+				return nil, fmt.Errorf("unsupported path variable %q", part)
+			}
+			if _, err := scalarField(md.Input(), v); err != nil {
+				return nil, err
+			}
+			rt.segments = append(rt.segments, segment{variable: v})
+		case strings.ContainsAny(part, "{}*"):
+			return nil, fmt.Errorf("unsupported path segment %q", part)
+		default:
+			rt.segments = append(rt.segments, segment{literal: part})
+		}
+	}
+
+	if rt.body != "" && rt.body != "*" {
+		fd := md.Input().Fields().ByName(protoreflect.Name(rt.body))
+		if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, fmt.Errorf("body %q must name a message field", rt.body)
+		}
+	}
+	if name := rule.GetResponseBody(); name != "" {
+		fd := md.Output().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, fmt.Errorf("response_body %q must name a message field", name)
+		}
+		rt.responseField = fd
+	}
+	return rt, nil
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// match reports whether the escaped request path segments fit the
+// template, returning the unescaped variable values.
+func (rt *route) match(parts []string) (map[string]string, bool) {
+	if len(parts) != len(rt.segments) {
+		return nil, false
+	}
+	vars := map[string]string{}
+	for i, seg := range rt.segments {
+		part, err := url.PathUnescape(parts[i])
+		if err != nil {
+			return nil, false
+		}
+		switch {
+		case seg.wildcard:
+		case seg.variable != "":
+			vars[seg.variable] = part
+		case seg.literal != part:
+			return nil, false
+		}
+	}
+	return vars, true
+}
+
+func (rt *route) responseBody(res proto.Message) proto.Message {
+	if rt.responseField == nil {
+		return res
+	}
+	return res.ProtoReflect().Get(rt.responseField).Message().Interface()
+}
+
+// request builds the request message of a unary or server-streaming call
+// from the body, the path variables and the query string.
+func (rt *route) request(r *http.Request, vars map[string]string) (proto.Message, error) {
+	req := newMessage(rt.method.Input())
+	if rt.body != "" {
+		data, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("reading body: %v", err))
+		}
+		if err := rt.decodeBody(req, data); err != nil {
+			return nil, err
+		}
+	}
+	if err := rt.bind(req, vars); err != nil {
+		return nil, err
+	}
+	if rt.body != "*" {
+		for key, values := range r.URL.Query() {
+			for _, v := range values {
+				if err := setField(req.ProtoReflect(), key, v); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("query parameter %s: %v", key, err))
+				}
+			}
+		}
+	}
+	return req, nil
+}
+
+// streamRequests reads the request messages of a client-streaming call,
+// one JSON value per line of the body.
+func (rt *route) streamRequests(r *http.Request, vars map[string]string) ([]proto.Message, error) {
+	dec := json.NewDecoder(io.LimitReader(r.Body, maxBodyBytes))
+	var reqs []proto.Message
+	for {
+		var raw json.RawMessage
+		err := dec.Decode(&raw)
+		if err == io.EOF {
+			return reqs, nil
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("request %d: %v", len(reqs)+1, err))
+		}
+		req := newMessage(rt.method.Input())
+		if err := rt.decodeBody(req, raw); err != nil {
+			return nil, err
+		}
+		if err := rt.bind(req, vars); err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, req)
+	}
+}
+
+func (rt *route) decodeBody(req proto.Message, data []byte) error {
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil
+	}
+	target := req
+	if rt.body != "*" {
+		m := req.ProtoReflect()
+		target = m.Mutable(m.Descriptor().Fields().ByName(protoreflect.Name(rt.body))).Message().Interface()
+	}
+	if err := unmarshaler.Unmarshal(data, target); err != nil {
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid JSON body: %v", err))
+	}
+	return nil
+}
+
+// bind copies the path variables into req. They win over the body.
+func (rt *route) bind(req proto.Message, vars map[string]string) error {
+	for path, v := range vars {
+		if err := setField(req.ProtoReflect(), path, v); err != nil {
+			return status.Errorf(codes.InvalidArgument, fmt.Sprintf("path parameter %s: %v", path, err))
+		}
+	}
+	return nil
+}
+
+// scalarField resolves a dotted path of field names to a scalar field.
+func scalarField(md protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %q in %s", name, md.FullName())
+		}
+		if i == len(names)-1 {
+			if fd.Message() != nil || fd.IsMap() {
+				return nil, fmt.Errorf("field %q is not a scalar", path)
+			}
+			return fd, nil
+		}
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, fmt.Errorf("field %q is not a singular message", name)
+		}
+		md = fd.Message()
+	}
+	return nil, fmt.Errorf("empty field path")
+}
+
+// setField parses value into the field at path, appending to repeated fields.
+func setField(m protoreflect.Message, path, value string) error {
+	fd, err := scalarField(m.Descriptor(), path)
+	if err != nil {
+		return err
+	}
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		m = m.Mutable(m.Descriptor().Fields().ByName(protoreflect.Name(name))).Message()
+	}
+	v, err := parseScalar(fd, value)
+	if err != nil {
+		return err
+	}
+	if fd.IsList() {
+		m.Mutable(fd).List().Append(v)
+		return nil
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+func parseScalar(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.BytesKind:
+		b, err := base64.URLEncoding.DecodeString(s)
+		if err != nil {
+			b, err = base64.StdEncoding.DecodeString(s)
+		}
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown %s value %q", fd.Enum().FullName(), s)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+}
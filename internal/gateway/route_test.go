@@ -0,0 +1,156 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	_ "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	_ "github.com/dipjyotimetia/gogrpc/calculator/calcpb"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newTestGateway(t *testing.T) *Gateway {
+	t.Helper()
+	// routing never touches the connection
+	g, err := New(nil, "blog.BlogService", "blog.AuthorService", "calc.SumService")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+// find picks the route ServeHTTP would call.
+func (g *Gateway) find(method, path string) (*route, map[string]string) {
+	parts := splitPath(path)
+	for _, rt := range g.routes {
+		if vars, ok := rt.match(parts); ok && rt.httpMethod == method {
+			return rt, vars
+		}
+	}
+	return nil, nil
+}
+
+func TestRouteMatching(t *testing.T) {
+	g := newTestGateway(t)
+	tests := []struct {
+		method, path string
+		want         string // full method, empty when nothing matches
+		vars         map[string]string
+	}{
+		{"POST", "/v1/blogs", "/blog.BlogService/CreateBlog", map[string]string{}},
+		{"GET", "/v1/blogs", "/blog.BlogService/ListBlog", map[string]string{}},
+		{"GET", "/v1/blogs/", "/blog.BlogService/ListBlog", map[string]string{}},
+		{"GET", "/v1/blogs/abc", "/blog.BlogService/ReadBlog", map[string]string{"blog_id": "abc"}},
+		{"PUT", "/v1/blogs/abc", "/blog.BlogService/UpdateBlog", map[string]string{"blog.id": "abc"}},
+		{"DELETE", "/v1/blogs/abc", "/blog.BlogService/DeleteBlog", map[string]string{"blog_id": "abc"}},
+		{"GET", "/v1/blogs/abc/related", "/blog.BlogService/GetRelatedBlogs", map[string]string{"blog_id": "abc"}},
+		{"GET", "/v1/authors/ada%2Flovelace", "/blog.AuthorService/GetAuthor", map[string]string{"author_id": "ada/lovelace"}},
+		{"GET", "/v1/square-root/16", "/calc.SumService/SquareRoot", map[string]string{"number": "16"}},
+		{"GET", "/v1/blogs/abc/unrelated", "", nil},
+		{"GET", "/v1/blogs/abc/related/more", "", nil},
+		{"PATCH", "/v1/blogs/abc", "", nil},
+		{"GET", "/v1/authors/bad%zzescape", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rt, vars := g.find(tt.method, tt.path)
+			if tt.want == "" {
+				if rt != nil {
+					t.Fatalf("matched %s, want no route", rt.fullMethod)
+				}
+				return
+			}
+			if rt == nil || rt.fullMethod != tt.want {
+				t.Fatalf("matched %v, want %s", rt, tt.want)
+			}
+			if len(vars) != len(tt.vars) {
+				t.Fatalf("vars = %v, want %v", vars, tt.vars)
+			}
+			for k, v := range tt.vars {
+				if vars[k] != v {
+					t.Errorf("vars = %v, want %v", vars, tt.vars)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestBinding(t *testing.T) {
+	g := newTestGateway(t)
+	tests := []struct {
+		method, target, body string
+		want                 string // request as JSON with proto names, or the error code
+	}{
+		{"GET", "/v1/blogs/abc/related?limit=3", "", `{"blog_id":"abc","limit":3}`},
+		{"PUT", "/v1/blogs/abc", `{"title":"Notes"}`, `{"blog":{"id":"abc","title":"Notes"}}`},
+		// the path wins over the body
+		{"PUT", "/v1/blogs/abc", `{"id":"xyz"}`, `{"blog":{"id":"abc"}}`},
+		{"GET", "/v1/square-root/16", "", `{"number":16}`},
+		{"GET", "/v1/square-root/four", "", "InvalidArgument"},
+		{"GET", "/v1/blogs/abc/related?limit=many", "", "InvalidArgument"},
+		{"PUT", "/v1/blogs/abc", `{"title":`, "InvalidArgument"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.target, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			rt, vars := g.find(tt.method, r.URL.EscapedPath())
+			if rt == nil {
+				t.Fatal("no route")
+			}
+			req, err := rt.request(r, vars)
+			if err != nil {
+				if got := status.Code(err).String(); got != tt.want {
+					t.Fatalf("request = %v, want %s", err, tt.want)
+				}
+				return
+			}
+			data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !sameJSON(t, string(data), tt.want) {
+				t.Errorf("request = %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnroutedStatus(t *testing.T) {
+	g := newTestGateway(t)
+	tests := []struct {
+		method, path string
+		want         int
+	}{
+		{"GET", "/v1/nothing", http.StatusNotFound},
+		{"PATCH", "/v1/blogs/abc", http.StatusMethodNotAllowed},
+		{"GET", "/v1/sum", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+		if w.Code != tt.want {
+			t.Errorf("%s %s = %d, want %d", tt.method, tt.path, w.Code, tt.want)
+		}
+		if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+			t.Errorf("%s %s Content-Type = %q, want JSON", tt.method, tt.path, ct)
+		}
+	}
+}
+
+func sameJSON(t *testing.T, a, b string) bool {
+	t.Helper()
+	var x, y interface{}
+	if err := json.Unmarshal([]byte(a), &x); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(b), &y); err != nil {
+		t.Fatal(err)
+	}
+	xs, _ := json.Marshal(x)
+	ys, _ := json.Marshal(y)
+	return string(xs) == string(ys)
+}
@@ -0,0 +1,450 @@
+// Package grpcweb serves gRPC-Web next to native gRPC on the same port, so
+// browser apps can call the services without a translating proxy.
+//
+// Both the binary (application/grpc-web) and the text
+// (application/grpc-web-text, base64) modes are supported, with CORS
+// preflight handling. Calls are forwarded, frame by frame, to an in-process
+// server hosting only the services open to browsers, built with the
+// interceptors of the native server, and are drained on Shutdown like
+// native calls. Browsers cannot stream request bodies, so the request is
+// read in full before the call starts; streamed responses are flushed
+// message by message and the status is sent as a final trailer frame.
+package grpcweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	contentTypeWeb  = "application/grpc-web"
+	contentTypeText = "application/grpc-web-text"
+
+	trailerFlag     = 0x80
+	maxRequestBytes = 4 << 20
+)
+
+// skippedHeaders are HTTP headers never forwarded as gRPC metadata.
+var skippedHeaders = map[string]bool{
+	"accept":          true,
+	"accept-encoding": true,
+	"connection":      true,
+	"content-length":  true,
+	"content-type":    true,
+	"cookie":          true,
+	"grpc-timeout":    true,
+	"host":            true,
+	"keep-alive":      true,
+	"origin":          true,
+	"referer":         true,
+	"te":              true,
+	"x-grpc-web":      true,
+	"x-user-agent":    true,
+}
+
+// Handler is an http.Handler translating gRPC-Web requests into calls on a
+// gRPC connection.
+type Handler struct {
+	conn      grpc.ClientConnInterface
+	anyOrigin bool
+	origins   map[string]bool
+}
+
+// Option configures a Handler.
+type Option func(*Handler)
+
+// AllowedOrigins lists the origins allowed to call cross-origin; "*" allows
+// any and empty ones are ignored. Without it only same-origin requests are
+// served.
+func AllowedOrigins(origins ...string) Option {
+	return func(h *Handler) {
+		for _, o := range origins {
+			switch o {
+			case "":
+				continue
+			case "*":
+				h.anyOrigin = true
+			}
+			h.origins[strings.TrimSuffix(o, "/")] = true
+		}
+	}
+}
+
+// New returns a Handler forwarding the calls on conn.
+func New(conn grpc.ClientConnInterface, opts ...Option) *Handler {
+	h := &Handler{conn: conn, origins: map[string]bool{}}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// sameOrigin tells whether origin is the one r was sent to: browsers send
+// an Origin with their POST requests, same-origin ones included.
+func sameOrigin(r *http.Request, origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return u.Scheme == scheme && u.Host == r.Host
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if !h.anyOrigin && !h.origins[origin] && !sameOrigin(r, origin) {
+			http.Error(w, fmt.Sprintf("origin %s not allowed", origin), http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+	}
+	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		if headers := r.Header.Get("Access-Control-Request-Headers"); headers != "" {
+			w.Header().Set("Access-Control-Allow-Headers", headers)
+		}
+		w.Header().Set("Access-Control-Max-Age", "600")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.TrimSpace(contentType)
+	if !strings.HasPrefix(contentType, contentTypeWeb) {
+		http.Error(w, "expected a gRPC-Web request", http.StatusUnsupportedMediaType)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "gRPC-Web requests must be POSTs", http.StatusMethodNotAllowed)
+		return
+	}
+	if strings.HasPrefix(contentType, contentTypeText) {
+		h.serve(r, &responseWriter{w: w, contentType: contentType, text: true})
+		return
+	}
+	h.serve(r, &responseWriter{w: w, contentType: contentType})
+}
+
+func (h *Handler) serve(r *http.Request, rw *responseWriter) {
+	msgs, err := readRequest(r, rw.text)
+	if err != nil {
+		rw.writeStatus(status.Convert(err), nil)
+		return
+	}
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	if v := r.Header.Get("Grpc-Timeout"); v != "" {
+		timeout, err := parseTimeout(v)
+		if err != nil {
+			rw.writeStatus(status.New(codes.InvalidArgument, fmt.Sprintf("malformed grpc-timeout %q", v)), nil)
+			return
+		}
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	md, err := requestMetadata(r)
+	if err != nil {
+		rw.writeStatus(status.New(codes.InvalidArgument, err.Error()), nil)
+		return
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	desc := &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}
+	stream, err := h.conn.NewStream(ctx, desc, r.URL.Path, grpc.ForceCodec(rawCodec{}))
+	if err != nil {
+		rw.writeStatus(status.Convert(err), nil)
+		return
+	}
+	go func() {
+		for _, msg := range msgs {
+			msg := msg
+			if err := stream.SendMsg(&msg); err != nil {
+				// the call has ended, RecvMsg reports why
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+
+	// an error here is the call's status, returned again by RecvMsg
+	header, _ := stream.Header()
+	rw.writeHeader(header)
+	for {
+		var msg []byte
+		err := stream.RecvMsg(&msg)
+		if err == io.EOF {
+			rw.writeStatus(status.New(codes.OK, ""), stream.Trailer())
+			return
+		}
+		if err != nil {
+			rw.writeStatus(status.Convert(err), stream.Trailer())
+			return
+		}
+		if err := rw.writeFrame(0, msg); err != nil {
+			return
+		}
+	}
+}
+
+// readRequest returns the messages framed in the request body.
+func readRequest(r *http.Request, text bool) ([][]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBytes+1))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "reading request: %v", err)
+	}
+	if len(data) > maxRequestBytes {
+		return nil, status.Errorf(codes.ResourceExhausted, "request larger than %d bytes", maxRequestBytes)
+	}
+	if text {
+		if data, err = decodeText(data); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid base64 body: %v", err)
+		}
+	}
+	var msgs [][]byte
+	for len(data) > 0 {
+		if len(data) < 5 {
+			return nil, status.Error(codes.InvalidArgument, "truncated frame header")
+		}
+		flag, n := data[0], binary.BigEndian.Uint32(data[1:5])
+		if flag != 0 {
+			return nil, status.Errorf(codes.Unimplemented, "unsupported frame flag %#x", flag)
+		}
+		if uint64(len(data)-5) < uint64(n) {
+			return nil, status.Error(codes.InvalidArgument, "truncated frame")
+		}
+		msgs = append(msgs, data[5:5+n])
+		data = data[5+n:]
+	}
+	return msgs, nil
+}
+
+// decodeText decodes a text-mode body, which may be a concatenation of
+// separately padded base64 chunks.
+func decodeText(data []byte) ([]byte, error) {
+	data = bytes.Join(bytes.Fields(data), nil)
+	var out []byte
+	for len(data) > 0 {
+		n := len(data)
+		if i := bytes.IndexByte(data, '='); i >= 0 {
+			n = (i/4 + 1) * 4
+			if n > len(data) {
+				return nil, errors.New("truncated padding")
+			}
+		}
+		chunk := make([]byte, base64.StdEncoding.DecodedLen(n))
+		m, err := base64.StdEncoding.Decode(chunk, data[:n])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, chunk[:m]...)
+		data = data[n:]
+	}
+	return out, nil
+}
+
+// requestMetadata forwards the request headers as gRPC metadata. Binary
+// metadata arrives base64-encoded, as in native gRPC.
+func requestMetadata(r *http.Request) (metadata.MD, error) {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		key = strings.ToLower(key)
+		if skippedHeaders[key] || strings.HasPrefix(key, "sec-") || strings.HasPrefix(key, "access-control-") || strings.HasPrefix(key, "grpc-") {
+			continue
+		}
+		for _, v := range values {
+			if strings.HasSuffix(key, "-bin") {
+				b, err := decodeBinary(v)
+				if err != nil {
+					return nil, fmt.Errorf("malformed binary header %s: %v", key, err)
+				}
+				v = string(b)
+			}
+			md.Append(key, v)
+		}
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		md.Append("x-forwarded-for", host)
+	}
+	mtls.Forward(md, r.TLS)
+	return md, nil
+}
+
+func decodeBinary(v string) ([]byte, error) {
+	if len(v)%4 == 0 {
+		return base64.StdEncoding.DecodeString(v)
+	}
+	return base64.RawStdEncoding.DecodeString(v)
+}
+
+// parseTimeout parses a grpc-timeout header value, such as "500m".
+func parseTimeout(v string) (time.Duration, error) {
+	if len(v) < 2 || len(v) > 9 {
+		return 0, errors.New("bad length")
+	}
+	units := map[byte]time.Duration{
+		'H': time.Hour,
+		'M': time.Minute,
+		'S': time.Second,
+		'm': time.Millisecond,
+		'u': time.Microsecond,
+		'n': time.Nanosecond,
+	}
+	unit, ok := units[v[len(v)-1]]
+	if !ok {
+		return 0, errors.New("bad unit")
+	}
+	n, err := strconv.ParseInt(v[:len(v)-1], 10, 64)
+	if err != nil || n < 0 {
+		return 0, errors.New("bad value")
+	}
+	return time.Duration(n) * unit, nil
+}
+
+// responseWriter writes gRPC-Web response frames, base64-encoding each one
+// in text mode so clients can decode them as they arrive.
+type responseWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	text        bool
+	wroteHeader bool
+}
+
+func (rw *responseWriter) writeHeader(md metadata.MD) {
+	if rw.wroteHeader {
+		return
+	}
+	rw.wroteHeader = true
+	h := rw.w.Header()
+	exposed := []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"}
+	for key, values := range md {
+		for _, v := range values {
+			h.Add(key, encodeMetadata(key, v))
+		}
+		exposed = append(exposed, http.CanonicalHeaderKey(key))
+	}
+	if h.Get("Access-Control-Allow-Origin") != "" {
+		sort.Strings(exposed[3:])
+		h.Set("Access-Control-Expose-Headers", strings.Join(exposed, ", "))
+	}
+	h.Set("Content-Type", rw.contentType)
+	h.Set("X-Content-Type-Options", "nosniff")
+	rw.w.WriteHeader(http.StatusOK)
+}
+
+func (rw *responseWriter) writeFrame(flag byte, data []byte) error {
+	frame := make([]byte, 5+len(data))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(data)))
+	copy(frame[5:], data)
+	if rw.text {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+	if _, err := rw.w.Write(frame); err != nil {
+		return err
+	}
+	if f, ok := rw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// writeStatus ends the response with a trailer frame holding st and the
+// trailer metadata.
+func (rw *responseWriter) writeStatus(st *status.Status, trailer metadata.MD) {
+	rw.writeHeader(nil)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "grpc-status: %d\r\n", st.Code())
+	if msg := st.Message(); msg != "" {
+		fmt.Fprintf(&buf, "grpc-message: %s\r\n", encodeGrpcMessage(msg))
+	}
+	if p := st.Proto(); len(p.GetDetails()) > 0 {
+		if data, err := proto.Marshal(p); err == nil {
+			fmt.Fprintf(&buf, "grpc-status-details-bin: %s\r\n", base64.RawStdEncoding.EncodeToString(data))
+		}
+	}
+	keys := make([]string, 0, len(trailer))
+	for key := range trailer {
+		// trailers-only responses carry the headers as well
+		if key != "content-type" && !strings.HasPrefix(key, "grpc-") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, v := range trailer[key] {
+			fmt.Fprintf(&buf, "%s: %s\r\n", key, encodeMetadata(key, v))
+		}
+	}
+	rw.writeFrame(trailerFlag, buf.Bytes())
+}
+
+func encodeMetadata(key, v string) string {
+	if strings.HasSuffix(key, "-bin") {
+		return base64.RawStdEncoding.EncodeToString([]byte(v))
+	}
+	return v
+}
+
+// encodeGrpcMessage percent-encodes the status message as the gRPC
+// protocol requires for grpc-message.
+func encodeGrpcMessage(msg string) string {
+	var b strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+// rawCodec passes the serialized messages through untouched; the server
+// end decodes them with the real codec.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("grpcweb: cannot marshal %T", v)
+	}
+	return *b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("grpcweb: cannot unmarshal into %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string { return "proto" }
@@ -0,0 +1,40 @@
+package grpcweb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOrigins(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		origin  string
+		code    int
+	}{
+		{"no origin", nil, "", http.StatusNoContent},
+		{"same origin", nil, "http://example.com", http.StatusNoContent},
+		{"same host over another scheme", nil, "https://example.com", http.StatusForbidden},
+		{"other site", nil, "http://evil.example", http.StatusForbidden},
+		{"empty list", []string{""}, "http://evil.example", http.StatusForbidden},
+		{"listed site", []string{"http://app.example/"}, "http://app.example", http.StatusNoContent},
+		{"unlisted site", []string{"http://app.example"}, "http://evil.example", http.StatusForbidden},
+		{"any site", []string{"*"}, "http://evil.example", http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(nil, AllowedOrigins(tt.allowed...))
+			r := httptest.NewRequest(http.MethodOptions, "http://example.com/greet.GreetService/Greet", nil)
+			r.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != tt.code {
+				t.Errorf("status %d, want %d", w.Code, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package grpcweb
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// http2Preface opens every HTTP/2 connection, so every native gRPC one.
+const http2Preface = "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
+
+// sniffTimeout bounds the TLS handshake and the wait for the first bytes.
+const sniffTimeout = 10 * time.Second
+
+// Mux splits the connections accepted on one listener between native gRPC
+// (HTTP/2) and gRPC-Web (HTTP/1.1).
+//
+// Plaintext connections are told apart by the HTTP/2 client preface. With a
+// TLS config the mux terminates TLS itself and routes on the negotiated
+// ALPN protocol: clients also offering http/1.1, such as browsers, are kept
+// on HTTP/1.1, the others get h2. The grpc.Server serving the GRPC listener
+// must then use TLSCredentials.
+type Mux struct {
+	lis       net.Listener
+	tlsConfig *tls.Config
+	grpcL     *connListener
+	httpL     *connListener
+}
+
+// NewMux returns a Mux over lis. tlsConfig may be nil for plaintext.
+func NewMux(lis net.Listener, tlsConfig *tls.Config) *Mux {
+	m := &Mux{
+		lis:   lis,
+		grpcL: newConnListener(lis.Addr()),
+		httpL: newConnListener(lis.Addr()),
+	}
+	if tlsConfig != nil {
+		base := tlsConfig.Clone()
+		m.tlsConfig = &tls.Config{
+			GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+				cfg := base
+				if base.GetConfigForClient != nil {
+					c, err := base.GetConfigForClient(hello)
+					if err != nil {
+						return nil, err
+					}
+					if c != nil {
+						cfg = c
+					}
+				}
+				cfg = cfg.Clone()
+				cfg.NextProtos = []string{"h2"}
+				for _, p := range hello.SupportedProtos {
+					if p == "http/1.1" {
+						cfg.NextProtos = []string{"http/1.1"}
+					}
+				}
+				return cfg, nil
+			},
+		}
+	}
+	return m
+}
+
+// GRPC returns the listener of the native gRPC connections.
+func (m *Mux) GRPC() net.Listener { return m.grpcL }
+
+// HTTP returns the listener of the HTTP/1.1 connections.
+func (m *Mux) HTTP() net.Listener { return m.httpL }
+
+// Serve accepts connections until the underlying listener is closed, then
+// closes the GRPC and HTTP listeners.
+func (m *Mux) Serve() error {
+	defer m.httpL.Close()
+	defer m.grpcL.Close()
+	for {
+		c, err := m.lis.Accept()
+		if err != nil {
+			return err
+		}
+		go m.route(c)
+	}
+}
+
+// Close closes the underlying listener.
+func (m *Mux) Close() error {
+	return m.lis.Close()
+}
+
+func (m *Mux) route(c net.Conn) {
+	c.SetDeadline(time.Now().Add(sniffTimeout))
+	target := m.httpL
+	if m.tlsConfig != nil {
+		tc := tls.Server(c, m.tlsConfig)
+		if err := tc.Handshake(); err != nil {
+			c.Close()
+			return
+		}
+		if tc.ConnectionState().NegotiatedProtocol == "h2" {
+			target = m.grpcL
+		}
+		c = tc
+	} else {
+		br := bufio.NewReaderSize(c, len(http2Preface))
+		isHTTP2 := true
+		for i := 1; i <= len(http2Preface) && isHTTP2; i++ {
+			b, err := br.Peek(i)
+			if err != nil {
+				c.Close()
+				return
+			}
+			isHTTP2 = b[i-1] == http2Preface[i-1]
+		}
+		if isHTTP2 {
+			target = m.grpcL
+		}
+		c = &peekedConn{Conn: c, r: br}
+	}
+	c.SetDeadline(time.Time{})
+	target.deliver(c)
+}
+
+// peekedConn replays the bytes read while sniffing.
+type peekedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *peekedConn) Read(p []byte) (int, error) { return c.r.Read(p) }
+
+// connListener hands out the connections routed to it by a Mux.
+type connListener struct {
+	addr      net.Addr
+	conns     chan net.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newConnListener(addr net.Addr) *connListener {
+	return &connListener{addr: addr, conns: make(chan net.Conn), done: make(chan struct{})}
+}
+
+func (l *connListener) deliver(c net.Conn) {
+	select {
+	case l.conns <- c:
+	case <-l.done:
+		c.Close()
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.closeOnce.Do(func() { close(l.done) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr { return l.addr }
+
+// TLSCredentials are the transport credentials of a grpc.Server serving the
+// GRPC listener of a TLS Mux. The mux has already done the handshake; they
+// only expose its state to the handlers as a credentials.TLSInfo.
+// Connections that did not come through the mux, such as the in-process
+// ones of the gRPC-Web handler, are accepted as they are.
+func TLSCredentials() credentials.TransportCredentials { return muxCredentials{} }
+
+type muxCredentials struct{}
+
+func (muxCredentials) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("grpcweb: mux credentials are server side only")
+}
+
+func (muxCredentials) ServerHandshake(c net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	tc, ok := c.(*tls.Conn)
+	if !ok {
+		return c, nil, nil
+	}
+	info := credentials.TLSInfo{
+		State:          tc.ConnectionState(),
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+	}
+	return tc, info, nil
+}
+
+func (muxCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls", SecurityVersion: "1.2"}
+}
+
+func (c muxCredentials) Clone() credentials.TransportCredentials { return c }
+
+func (muxCredentials) OverrideServerName(string) error { return nil }
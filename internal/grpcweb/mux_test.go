@@ -0,0 +1,157 @@
+package grpcweb
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+func startMux(t *testing.T, tlsConfig *tls.Config) (*Mux, string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewMux(lis, tlsConfig)
+	go m.Serve()
+	t.Cleanup(func() { m.Close() })
+	return m, lis.Addr().String()
+}
+
+// accepted returns which listener of m the next connection is handed to,
+// "none" when none is within a second.
+func accepted(t *testing.T, m *Mux) (string, net.Conn) {
+	t.Helper()
+	type result struct {
+		name string
+		c    net.Conn
+	}
+	got := make(chan result, 2)
+	for name, l := range map[string]net.Listener{"grpc": m.GRPC(), "http": m.HTTP()} {
+		name, l := name, l
+		go func() {
+			if c, err := l.Accept(); err == nil {
+				got <- result{name, c}
+			}
+		}()
+	}
+	select {
+	case r := <-got:
+		t.Cleanup(func() { r.c.Close() })
+		return r.name, r.c
+	case <-time.After(time.Second):
+		return "none", nil
+	}
+}
+
+func TestMuxPlaintext(t *testing.T) {
+	tests := []struct {
+		name  string
+		first string
+		close bool // client hangs up after first
+		want  string
+	}{
+		{"http2 preface", http2Preface + "\x00\x00\x00\x04", false, "grpc"},
+		{"http/1.1 request", "POST /greet.GreetService/Greet HTTP/1.1\r\n", false, "http"},
+		{"preface prefix then http", "PRI * HTTP/1.1\r\n\r\nxxxxxxxx", false, "http"},
+		{"hang up while sniffing", "PRI *", true, "none"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, addr := startMux(t, nil)
+			c, err := net.Dial("tcp", addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+			if _, err := io.WriteString(c, tt.first); err != nil {
+				t.Fatal(err)
+			}
+			if tt.close {
+				c.Close()
+			}
+
+			got, sc := accepted(t, m)
+			if got != tt.want {
+				t.Fatalf("routed to %s, want %s", got, tt.want)
+			}
+			if sc == nil {
+				return
+			}
+			// the sniffed bytes are replayed
+			buf := make([]byte, len(tt.first))
+			if _, err := io.ReadFull(sc, buf); err != nil || string(buf) != tt.first {
+				t.Errorf("read %q, %v, want %q", buf, err, tt.first)
+			}
+		})
+	}
+}
+
+func TestMuxTLS(t *testing.T) {
+	cert := selfSigned(t)
+	tests := []struct {
+		name   string
+		protos []string
+		want   string
+		alpn   string
+	}{
+		{"native gRPC", []string{"h2"}, "grpc", "h2"},
+		{"browser", []string{"h2", "http/1.1"}, "http", "http/1.1"},
+		{"http/1.1 only", []string{"http/1.1"}, "http", "http/1.1"},
+		{"no ALPN", nil, "http", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, addr := startMux(t, &tls.Config{Certificates: []tls.Certificate{cert}, NextProtos: []string{"h2", "http/1.1"}})
+			done := make(chan string, 1)
+			go func() {
+				c, err := tls.Dial("tcp", addr, &tls.Config{InsecureSkipVerify: true, NextProtos: tt.protos})
+				if err != nil {
+					done <- "error: " + err.Error()
+					return
+				}
+				defer c.Close()
+				done <- c.ConnectionState().NegotiatedProtocol
+				io.Copy(io.Discard, c)
+			}()
+
+			got, _ := accepted(t, m)
+			if got != tt.want {
+				t.Fatalf("routed to %s, want %s", got, tt.want)
+			}
+			if alpn := <-done; alpn != tt.alpn {
+				t.Errorf("negotiated %q, want %q", alpn, tt.alpn)
+			}
+		})
+	}
+}
+
+func selfSigned(t *testing.T) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
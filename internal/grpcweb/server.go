@@ -0,0 +1,82 @@
+package grpcweb
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"github.com/dipjyotimetia/gogrpc/internal/inprocess"
+	"github.com/dipjyotimetia/gogrpc/internal/lifecycle"
+	"google.golang.org/grpc"
+)
+
+// Server serves a grpc.Server natively, and the services open to browsers
+// over gRPC-Web, on one listener.
+type Server struct {
+	grpc   *grpc.Server
+	public *inprocess.Server
+	mux    *Mux
+	conn   *grpc.ClientConn
+	http   *http.Server
+}
+
+// NewServer prepares s, with its services already registered, to be served
+// on lis. Over gRPC-Web only the services registered by public are served,
+// on an in-process server built with serverOpts, the options of s, so that
+// browser calls run the same interceptors. With a tlsConfig, s must be
+// built with TLSCredentials.
+func NewServer(s *grpc.Server, lis net.Listener, tlsConfig *tls.Config, public func(*grpc.Server), serverOpts []grpc.ServerOption, opts ...Option) (*Server, error) {
+	inproc := inprocess.NewServer(serverOpts...)
+	public(inproc.Server)
+	inproc.Start()
+	conn, err := inproc.Dial(context.Background())
+	if err != nil {
+		inproc.Stop()
+		return nil, err
+	}
+	return &Server{
+		grpc:   s,
+		public: inproc,
+		mux:    NewMux(lis, tlsConfig),
+		conn:   conn,
+		http:   &http.Server{Handler: New(conn, opts...)},
+	}, nil
+}
+
+// Serve serves until the grpc.Server is stopped or Close is called.
+func (s *Server) Serve() error {
+	go s.mux.Serve()
+	go s.http.Serve(s.mux.HTTP())
+	return s.grpc.Serve(s.mux.GRPC())
+}
+
+// Close stops serving gRPC-Web and closes the listener. Stop the
+// grpc.Server itself as usual.
+func (s *Server) Close() {
+	s.http.Close()
+	s.conn.Close()
+	s.public.Stop()
+	s.mux.Close()
+}
+
+// Shutdown stops accepting connections and waits for the running native
+// and gRPC-Web calls, then stops the grpc.Server. When ctx is done first,
+// the calls left are cancelled.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mux.Close()
+	errs := make(chan error, 1)
+	go func() { errs <- s.http.Shutdown(ctx) }()
+	err := lifecycle.GracefulStop(ctx, s.grpc)
+	if werr := <-errs; werr != nil {
+		s.http.Close()
+		if err == nil {
+			err = werr
+		}
+	}
+	if perr := lifecycle.GracefulStop(ctx, s.public.Server); err == nil {
+		err = perr
+	}
+	s.conn.Close()
+	return err
+}
@@ -0,0 +1,245 @@
+// Package healthcheck reports the health of a server through the
+// grpc.health.v1.Health service and an HTTP bridge for orchestrators.
+//
+// A Monitor probes the dependencies of the services, like the MongoDB of
+// the blog services or the certificate of a TLS server, every interval. A
+// service is SERVING while every check it depends on passes, and the
+// server as a whole, the empty service name, while all its services are.
+// Health clients can Check or Watch any of them. Over HTTP, /healthz
+// answers while the process runs and /readyz answers 200 only while the
+// server, or the service named by ?service=, is SERVING, listing its
+// checks as ok or failing.
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// A Check probes a dependency, failing with the reason it is unusable.
+type Check func(ctx context.Context) error
+
+type check struct {
+	name     string
+	run      Check
+	services []string
+	err      error
+}
+
+// Monitor runs the checks of a server and keeps its health statuses.
+type Monitor struct {
+	server   *health.Server
+	interval time.Duration
+	timeout  time.Duration
+	log      *logging.Logger
+
+	mu       sync.Mutex
+	services []string
+	checks   []*check
+	stop     context.CancelFunc
+	done     chan struct{}
+}
+
+// New returns a Monitor running the checks every interval, each given
+// timeout to complete, and logging their failures to log.
+func New(interval, timeout time.Duration, log *logging.Logger) *Monitor {
+	return &Monitor{server: health.NewServer(), interval: interval, timeout: timeout, log: log}
+}
+
+// Server returns the health server, to be shut down with the process.
+func (m *Monitor) Server() *health.Server {
+	return m.server
+}
+
+// Register registers the grpc.health.v1.Health service on s.
+func (m *Monitor) Register(s *grpc.Server) {
+	healthpb.RegisterHealthServer(s, m.server)
+}
+
+// AddService adds services, NOT_SERVING until the first checks pass.
+func (m *Monitor) AddService(names ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.services = append(m.services, names...)
+	m.update()
+}
+
+// AddCheck adds a check the services depend on, all of them when none
+// are given.
+func (m *Monitor) AddCheck(name string, run Check, services ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checks = append(m.checks, &check{name: name, run: run, services: services, err: errNotChecked})
+	m.update()
+}
+
+var errNotChecked = errors.New("not checked yet")
+
+// Start runs the checks now, then every interval until Stop.
+func (m *Monitor) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.stop = cancel
+	m.done = make(chan struct{})
+	m.runChecks(ctx)
+	go func() {
+		defer close(m.done)
+		t := time.NewTicker(m.interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				m.runChecks(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops running the checks, before the dependencies are closed.
+func (m *Monitor) Stop(ctx context.Context) error {
+	if m.stop == nil {
+		return nil
+	}
+	m.stop()
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *Monitor) runChecks(ctx context.Context) {
+	m.mu.Lock()
+	checks := append([]*check(nil), m.checks...)
+	m.mu.Unlock()
+
+	errs := make([]error, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func(i int, c *check) {
+			defer wg.Done()
+			cctx, cancel := context.WithTimeout(ctx, m.timeout)
+			defer cancel()
+			errs[i] = c.run(cctx)
+		}(i, c)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, c := range checks {
+		switch {
+		case errs[i] != nil && (c.err == nil || c.err == errNotChecked):
+			m.log.Warn("health check failing", "check", c.name, "error", errs[i])
+		case errs[i] == nil && c.err != nil && c.err != errNotChecked:
+			m.log.Info("health check passing again", "check", c.name)
+		}
+		c.err = errs[i]
+	}
+	m.update()
+}
+
+// update sets the statuses from the last results, m.mu held.
+func (m *Monitor) update() {
+	all := healthpb.HealthCheckResponse_SERVING
+	for _, name := range m.services {
+		st := healthpb.HealthCheckResponse_SERVING
+		for _, c := range m.checks {
+			if c.err != nil && c.appliesTo(name) {
+				st = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+		}
+		if st != healthpb.HealthCheckResponse_SERVING {
+			all = st
+		}
+		m.server.SetServingStatus(name, st)
+	}
+	m.server.SetServingStatus("", all)
+}
+
+func (c *check) appliesTo(service string) bool {
+	if len(c.services) == 0 {
+		return true
+	}
+	for _, s := range c.services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// ServeHTTP serves /healthz and /readyz.
+func (m *Monitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/healthz":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(w, "ok")
+	case "/readyz":
+		m.serveReady(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (m *Monitor) serveReady(w http.ResponseWriter, r *http.Request) {
+	service := r.URL.Query().Get("service")
+	res, err := m.server.Check(r.Context(), &healthpb.HealthCheckRequest{Service: service})
+	if status.Code(err) == codes.NotFound {
+		http.Error(w, fmt.Sprintf("unknown service %q", service), http.StatusNotFound)
+		return
+	}
+	ready := readiness{Status: res.GetStatus().String(), Checks: map[string]string{}}
+	m.mu.Lock()
+	for _, c := range m.checks {
+		if service != "" && !c.appliesTo(service) {
+			continue
+		}
+		ready.Checks[c.name] = "ok"
+		if c.err != nil {
+			// the reasons are logged, they may name internal hosts
+			ready.Checks[c.name] = "failing"
+		}
+	}
+	m.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(ready)
+}
+
+// CertificateExpiry returns a check failing once the certificate expiring
+// at notAfter, like mtls.Reloader.NotAfter, has expired.
+func CertificateExpiry(notAfter func() time.Time) Check {
+	return func(context.Context) error {
+		if exp := notAfter(); time.Now().After(exp) {
+			return fmt.Errorf("certificate expired on %s", exp.Format(time.RFC3339))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,112 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	serving    = healthpb.HealthCheckResponse_SERVING
+	notServing = healthpb.HealthCheckResponse_NOT_SERVING
+)
+
+func TestStatuses(t *testing.T) {
+	var mongoErr, certErr error
+	m := New(time.Minute, time.Second, logging.New(io.Discard, logging.Info))
+	m.AddService("blog.BlogService", "greet.GreetService")
+	m.AddCheck("mongo", func(context.Context) error { return mongoErr }, "blog.BlogService")
+	m.AddCheck("certificate", func(context.Context) error { return certErr })
+
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		res, err := m.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Status
+	}
+
+	tests := []struct {
+		name             string
+		mongo, cert      error
+		skip             bool // the checks have not run yet
+		blog, greet, all healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{name: "not checked yet", skip: true, blog: notServing, greet: notServing, all: notServing},
+		{name: "all passing", blog: serving, greet: serving, all: serving},
+		{name: "mongo down", mongo: errors.New("no reachable servers"), blog: notServing, greet: serving, all: notServing},
+		{name: "mongo back", blog: serving, greet: serving, all: serving},
+		{name: "certificate expired", cert: errors.New("expired"), blog: notServing, greet: notServing, all: notServing},
+		{name: "certificate renewed", blog: serving, greet: serving, all: serving},
+	}
+	for _, tt := range tests {
+		mongoErr, certErr = tt.mongo, tt.cert
+		if !tt.skip {
+			m.runChecks(context.Background())
+		}
+		if got := status("blog.BlogService"); got != tt.blog {
+			t.Errorf("%s: blog %v, want %v", tt.name, got, tt.blog)
+		}
+		if got := status("greet.GreetService"); got != tt.greet {
+			t.Errorf("%s: greet %v, want %v", tt.name, got, tt.greet)
+		}
+		if got := status(""); got != tt.all {
+			t.Errorf("%s: server %v, want %v", tt.name, got, tt.all)
+		}
+	}
+}
+
+func TestReadyz(t *testing.T) {
+	m := New(time.Minute, time.Second, logging.New(io.Discard, logging.Info))
+	m.AddService("blog.BlogService", "greet.GreetService")
+	m.AddCheck("mongo", func(context.Context) error { return errors.New("mongo.internal:27017 unreachable") }, "blog.BlogService")
+	m.AddCheck("certificate", func(context.Context) error { return nil })
+	m.runChecks(context.Background())
+
+	tests := []struct {
+		target string
+		code   int
+		checks map[string]string
+	}{
+		{"/healthz", http.StatusOK, nil},
+		{"/readyz", http.StatusServiceUnavailable, map[string]string{"mongo": "failing", "certificate": "ok"}},
+		{"/readyz?service=greet.GreetService", http.StatusOK, map[string]string{"certificate": "ok"}},
+		{"/readyz?service=blog.BlogService", http.StatusServiceUnavailable, map[string]string{"mongo": "failing", "certificate": "ok"}},
+		{"/readyz?service=calc.CalcService", http.StatusNotFound, nil},
+		{"/livez", http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+		if w.Code != tt.code {
+			t.Errorf("%s: status %d, want %d", tt.target, w.Code, tt.code)
+		}
+		if strings.Contains(w.Body.String(), "mongo.internal") {
+			t.Errorf("%s: the failure reason is shown: %s", tt.target, w.Body.String())
+		}
+		if tt.checks == nil {
+			continue
+		}
+		var ready readiness
+		if err := json.Unmarshal(w.Body.Bytes(), &ready); err != nil {
+			t.Fatalf("%s: %v", tt.target, err)
+		}
+		if len(ready.Checks) != len(tt.checks) {
+			t.Errorf("%s: checks %v, want %v", tt.target, ready.Checks, tt.checks)
+		}
+		for name, want := range tt.checks {
+			if ready.Checks[name] != want {
+				t.Errorf("%s: check %s = %q, want %q", tt.target, name, ready.Checks[name], want)
+			}
+		}
+	}
+}
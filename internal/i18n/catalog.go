@@ -0,0 +1,209 @@
+// Package i18n holds message catalogues for user-facing text, loaded from
+// one YAML file per locale, and picks messages through fallback chains
+// such as pt-BR → pt → en.
+//
+// A catalogue file looks like:
+//
+//	conjunction: and
+//	messages:
+//	  greet:
+//	    formal: Good day, {{.FullName}}.
+//	    casual:
+//	      one: Hi {{.FirstName}}!
+//	      other: Hi {{.FirstName}} and co!
+//
+// Every message has one entry per style. An entry is either a single
+// text/template or one template per CLDR plural category (zero, one, two,
+// few, many, other), chosen by the count passed to Format; "other" is
+// required. A locale may define only some messages or styles, the rest
+// comes from the next locale of the chain. The conjunction joins the last
+// item of lists.
+package i18n
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+var pluralCategories = map[string]bool{"zero": true, "one": true, "two": true, "few": true, "many": true, "other": true}
+
+// Catalog is the set of locales loaded from a directory.
+type Catalog struct {
+	fallback string
+	locales  map[string]*locale
+}
+
+type locale struct {
+	tag         string
+	conjunction string
+	// messages by key, then style, then plural category
+	messages map[string]map[string]map[string]*template.Template
+}
+
+// file is the YAML layout of a catalogue file.
+type file struct {
+	Conjunction string                      `yaml:"conjunction"`
+	Messages    map[string]map[string]forms `yaml:"messages"`
+}
+
+// forms is either a single template or one per plural category.
+type forms map[string]string
+
+func (f *forms) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var single string
+	if err := unmarshal(&single); err == nil {
+		*f = forms{"other": single}
+		return nil
+	}
+	var plural map[string]string
+	if err := unmarshal(&plural); err != nil {
+		return err
+	}
+	*f = plural
+	return nil
+}
+
+// Load reads every <tag>.yaml file of dir. fallback is the locale ending
+// every chain; it must define every message and style the others use.
+func Load(dir, fallback string) (*Catalog, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	c := &Catalog{fallback: Canonical(fallback), locales: map[string]*locale{}}
+	for _, path := range paths {
+		tag := Canonical(strings.TrimSuffix(filepath.Base(path), ".yaml"))
+		l, err := loadLocale(path, tag)
+		if err != nil {
+			return nil, fmt.Errorf("i18n: %s: %w", path, err)
+		}
+		c.locales[tag] = l
+	}
+	base, ok := c.locales[c.fallback]
+	if !ok {
+		return nil, fmt.Errorf("i18n: no catalogue for the fallback locale %s in %s", c.fallback, dir)
+	}
+	for _, l := range c.locales {
+		for key, styles := range l.messages {
+			for style := range styles {
+				if base.messages[key][style] == nil {
+					return nil, fmt.Errorf("i18n: %s defines %s/%s, missing from the fallback locale %s", l.tag, key, style, c.fallback)
+				}
+			}
+		}
+	}
+	return c, nil
+}
+
+func loadLocale(path, tag string) (*locale, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f file
+	if err := yaml.UnmarshalStrict(data, &f); err != nil {
+		return nil, err
+	}
+	l := &locale{tag: tag, conjunction: f.Conjunction, messages: map[string]map[string]map[string]*template.Template{}}
+	for key, styles := range f.Messages {
+		l.messages[key] = map[string]map[string]*template.Template{}
+		for style, fs := range styles {
+			if _, ok := fs["other"]; !ok {
+				return nil, fmt.Errorf("%s/%s has no \"other\" form", key, style)
+			}
+			parsed := map[string]*template.Template{}
+			for category, text := range fs {
+				if !pluralCategories[category] {
+					return nil, fmt.Errorf("%s/%s: unknown plural category %q", key, style, category)
+				}
+				name := fmt.Sprintf("%s/%s/%s/%s", tag, key, style, category)
+				t, err := template.New(name).Option("missingkey=error").Parse(text)
+				if err != nil {
+					return nil, err
+				}
+				parsed[category] = t
+			}
+			l.messages[key][style] = parsed
+		}
+	}
+	return l, nil
+}
+
+// Locales returns the loaded locale tags, sorted.
+func (c *Catalog) Locales() []string {
+	var tags []string
+	for tag := range c.locales {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// Localizer returns a Localizer for the preferred tags, most preferred
+// first. Each tag is tried with its parents before the next tag, and the
+// fallback locale comes last.
+func (c *Catalog) Localizer(tags ...string) *Localizer {
+	seen := map[string]bool{}
+	var chain []*locale
+	for _, tag := range append(append([]string(nil), tags...), c.fallback) {
+		for _, t := range parents(Canonical(tag)) {
+			if l, ok := c.locales[t]; ok && !seen[t] {
+				seen[t] = true
+				chain = append(chain, l)
+			}
+		}
+	}
+	return &Localizer{chain: chain}
+}
+
+// Localizer formats messages for one chain of locales.
+type Localizer struct {
+	chain []*locale
+}
+
+// Format executes the message key in style with data and returns it with
+// the locale it comes from, the first of the chain having the message.
+// count selects the plural form, using the plural rules of that locale.
+func (l *Localizer) Format(key, style string, count int, data interface{}) (text, locale string, err error) {
+	for _, loc := range l.chain {
+		fs := loc.messages[key][style]
+		if fs == nil {
+			continue
+		}
+		t, ok := fs[PluralCategory(loc.tag, count)]
+		if !ok {
+			t = fs["other"]
+		}
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, data); err != nil {
+			return "", "", fmt.Errorf("i18n: %w", err)
+		}
+		return buf.String(), loc.tag, nil
+	}
+	return "", "", fmt.Errorf("i18n: no message %s/%s", key, style)
+}
+
+// Join lists items as "a, b and c", with the conjunction of the locale.
+func (l *Localizer) Join(items []string) string {
+	conjunction := "and"
+	for _, loc := range l.chain {
+		if loc.conjunction != "" {
+			conjunction = loc.conjunction
+			break
+		}
+	}
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " " + conjunction + " " + items[len(items)-1]
+}
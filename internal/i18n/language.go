@@ -0,0 +1,116 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Canonical normalises a BCP 47 tag: "pt_br" becomes "pt-BR" and
+// "zh-hant-tw" becomes "zh-Hant-TW".
+func Canonical(tag string) string {
+	parts := strings.FieldsFunc(strings.TrimSpace(tag), func(r rune) bool { return r == '-' || r == '_' })
+	for i, p := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(p)
+		case len(p) == 2:
+			parts[i] = strings.ToUpper(p)
+		case len(p) == 4:
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		default:
+			parts[i] = strings.ToLower(p)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// parents returns tag followed by its truncations: pt-BR, pt.
+func parents(tag string) []string {
+	var out []string
+	for tag != "" {
+		out = append(out, tag)
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return out
+}
+
+// ParseAcceptLanguage returns the tags of an Accept-Language header value
+// by decreasing quality, dropping "*" and tags with q=0.
+func ParseAcceptLanguage(v string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var ws []weighted
+	for _, part := range strings.Split(v, ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if f, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = f
+				}
+			}
+		}
+		if q > 0 {
+			ws = append(ws, weighted{tag: Canonical(tag), q: q})
+		}
+	}
+	sort.SliceStable(ws, func(i, j int) bool { return ws[i].q > ws[j].q })
+	tags := make([]string, len(ws))
+	for i, w := range ws {
+		tags[i] = w.tag
+	}
+	return tags
+}
+
+// PluralCategory returns the CLDR cardinal plural category of n in the
+// language of tag. Languages without a rule here follow English.
+func PluralCategory(tag string, n int) string {
+	if n < 0 {
+		n = -n
+	}
+	lang := tag
+	if i := strings.IndexByte(tag, '-'); i >= 0 {
+		lang = tag[:i]
+	}
+	switch lang {
+	case "ja", "ko", "zh", "vi", "th", "id":
+		return "other"
+	case "fr", "pt":
+		if n == 0 || n == 1 {
+			return "one"
+		}
+		return "other"
+	case "ru", "uk":
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return "one"
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return "few"
+		}
+		return "many"
+	case "pl":
+		switch {
+		case n == 1:
+			return "one"
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return "few"
+		}
+		return "many"
+	}
+	if n == 1 {
+		return "one"
+	}
+	return "other"
+}
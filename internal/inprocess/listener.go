@@ -0,0 +1,68 @@
+package inprocess
+
+import (
+	"context"
+	"net"
+	"sync"
+)
+
+// Network is the network of the addresses of in-process connections.
+const Network = "inprocess"
+
+type addr struct{}
+
+func (addr) Network() string { return Network }
+func (addr) String() string  { return Network }
+
+// listener accepts one end of a net.Pipe per dial.
+type listener struct {
+	conns  chan net.Conn
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newListener() *listener {
+	return &listener{conns: make(chan net.Conn), closed: make(chan struct{})}
+}
+
+func (l *listener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *listener) Close() error {
+	l.once.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *listener) Addr() net.Addr {
+	return addr{}
+}
+
+func (l *listener) dial(ctx context.Context) (net.Conn, error) {
+	server, client := net.Pipe()
+	select {
+	case l.conns <- conn{server}:
+		return conn{client}, nil
+	case <-l.closed:
+		server.Close()
+		client.Close()
+		return nil, net.ErrClosed
+	case <-ctx.Done():
+		server.Close()
+		client.Close()
+		return nil, ctx.Err()
+	}
+}
+
+// conn reports in-process addresses rather than those of the pipe.
+type conn struct {
+	net.Conn
+}
+
+func (conn) LocalAddr() net.Addr  { return addr{} }
+func (conn) RemoteAddr() net.Addr { return addr{} }
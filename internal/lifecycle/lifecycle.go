@@ -0,0 +1,166 @@
+// Package lifecycle shuts servers down without dropping the calls in
+// flight.
+//
+// On SIGINT or SIGTERM, Wait marks every service of the health server as
+// NOT_SERVING so load balancers stop sending new calls, waits for the drain
+// period, then stops the servers gracefully: running calls, streams
+// included, are left to finish until the stop timeout, after which the
+// servers are stopped hard. The dependencies, like the store of the blog
+// service, are closed last, in the reverse order of their registration.
+package lifecycle
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+)
+
+// A Server stops accepting calls on Shutdown and returns once the running
+// ones are done, or stops them when ctx is done.
+type Server interface {
+	Shutdown(ctx context.Context) error
+}
+
+type dependency struct {
+	name  string
+	close func(ctx context.Context) error
+}
+
+// Lifecycle shuts down the servers, then the dependencies, of a process.
+type Lifecycle struct {
+	drain   time.Duration
+	timeout time.Duration
+	log     *logging.Logger
+
+	health       *health.Server
+	servers      []namedServer
+	dependencies []dependency
+}
+
+type namedServer struct {
+	name string
+	Server
+}
+
+// New returns a Lifecycle waiting drain before stopping the servers, and
+// giving them timeout to finish their calls.
+func New(drain, timeout time.Duration, log *logging.Logger) *Lifecycle {
+	return &Lifecycle{drain: drain, timeout: timeout, log: log}
+}
+
+// SetHealth sets the health server reporting NOT_SERVING on shutdown.
+func (l *Lifecycle) SetHealth(h *health.Server) {
+	l.health = h
+}
+
+// AddServer adds a server, stopped together with the others.
+func (l *Lifecycle) AddServer(name string, s Server) {
+	l.servers = append(l.servers, namedServer{name: name, Server: s})
+}
+
+// AddDependency adds a dependency, closed after the servers and after the
+// dependencies added later.
+func (l *Lifecycle) AddDependency(name string, close func(ctx context.Context) error) {
+	l.dependencies = append(l.dependencies, dependency{name: name, close: close})
+}
+
+// Wait blocks until SIGINT or SIGTERM, then shuts down.
+func (l *Lifecycle) Wait() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	sig := <-ch
+	signal.Stop(ch)
+	l.log.Info("received signal", "signal", sig.String())
+	l.Shutdown()
+}
+
+// Shutdown drains and stops the servers, then closes the dependencies.
+func (l *Lifecycle) Shutdown() {
+	if l.health != nil {
+		l.log.Info("reporting NOT_SERVING")
+		l.health.Shutdown()
+	}
+	if l.drain > 0 && len(l.servers) > 0 {
+		l.log.Info("draining", "drain_ms", l.drain.Milliseconds())
+		time.Sleep(l.drain)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	var wg sync.WaitGroup
+	for _, s := range l.servers {
+		wg.Add(1)
+		go func(s namedServer) {
+			defer wg.Done()
+			l.log.Info("stopping", "server", s.name)
+			if err := s.Shutdown(ctx); err != nil {
+				l.log.Warn("stopped hard", "server", s.name, "error", err)
+			}
+		}(s)
+	}
+	wg.Wait()
+	cancel()
+
+	ctx, cancel = context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+	for i := len(l.dependencies) - 1; i >= 0; i-- {
+		d := l.dependencies[i]
+		l.log.Info("closing", "dependency", d.name)
+		if err := d.close(ctx); err != nil {
+			l.log.Error("closing failed", "dependency", d.name, "error", err)
+		}
+	}
+}
+
+// GRPC adapts a grpc.Server: Shutdown calls GracefulStop, and Stop when
+// ctx is done first.
+func GRPC(s *grpc.Server) Server {
+	return grpcServer{s}
+}
+
+type grpcServer struct{ s *grpc.Server }
+
+func (g grpcServer) Shutdown(ctx context.Context) error {
+	return GracefulStop(ctx, g.s)
+}
+
+// GracefulStop calls s.GracefulStop, and s.Stop when ctx is done before
+// the running calls.
+func GracefulStop(ctx context.Context, s *grpc.Server) error {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.Stop()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// HTTP adapts an http.Server: Shutdown calls its Shutdown, and Close when
+// ctx is done first.
+func HTTP(s *http.Server) Server {
+	return httpServer{s}
+}
+
+type httpServer struct{ s *http.Server }
+
+func (h httpServer) Shutdown(ctx context.Context) error {
+	if err := h.s.Shutdown(ctx); err != nil {
+		h.s.Close()
+		return err
+	}
+	return nil
+}
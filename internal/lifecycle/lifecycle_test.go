@@ -0,0 +1,142 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// events records the shutdown steps in the order they happen.
+type events struct {
+	mu    sync.Mutex
+	start time.Time
+	list  []string
+	at    []time.Duration
+}
+
+func (e *events) add(s string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.list = append(e.list, s)
+	e.at = append(e.at, time.Since(e.start))
+}
+
+type fakeServer struct {
+	name   string
+	events *events
+	health *health.Server
+	// hang keeps a call running until ctx is done
+	hang bool
+}
+
+func (s *fakeServer) Shutdown(ctx context.Context) error {
+	res, err := s.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil || res.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		s.events.add(s.name + " stopped while serving")
+	}
+	if s.hang {
+		<-ctx.Done()
+		s.events.add(s.name + " stopped hard")
+		return ctx.Err()
+	}
+	s.events.add(s.name + " stopped")
+	return nil
+}
+
+func TestShutdownOrder(t *testing.T) {
+	const drain = 50 * time.Millisecond
+	tests := []struct {
+		name string
+		hang bool
+		want []string
+	}{
+		{
+			name: "graceful",
+			want: []string{"grpc stopped", "close cache", "close store"},
+		},
+		{
+			name: "hard stop after the timeout",
+			hang: true,
+			want: []string{"grpc stopped hard", "close cache", "close store"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev := &events{start: time.Now()}
+			h := health.NewServer()
+			h.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
+			l := New(drain, 100*time.Millisecond, logging.New(io.Discard, logging.Info))
+			l.SetHealth(h)
+			l.AddServer("grpc", &fakeServer{name: "grpc", events: ev, health: h, hang: tt.hang})
+			l.AddDependency("store", func(context.Context) error { ev.add("close store"); return nil })
+			l.AddDependency("cache", func(context.Context) error { ev.add("close cache"); return errors.New("already closed") })
+			l.Shutdown()
+
+			if len(ev.list) != len(tt.want) {
+				t.Fatalf("shutdown steps %q, want %q", ev.list, tt.want)
+			}
+			for i := range tt.want {
+				if ev.list[i] != tt.want[i] {
+					t.Errorf("step %d = %q, want %q", i, ev.list[i], tt.want[i])
+				}
+			}
+			if ev.at[0] < drain {
+				t.Errorf("servers stopped after %v, before the drain period", ev.at[0])
+			}
+		})
+	}
+}
+
+func TestGracefulStop(t *testing.T) {
+	tests := []struct {
+		name    string
+		stream  bool // a Watch stream is left running
+		wantErr bool
+	}{
+		{name: "idle"},
+		{name: "stream running", stream: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := grpc.NewServer()
+			healthpb.RegisterHealthServer(s, health.NewServer())
+			go s.Serve(lis)
+
+			if tt.stream {
+				conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer conn.Close()
+				w, err := healthpb.NewHealthClient(conn).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := w.Recv(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			if err := GRPC(s).Shutdown(ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Shutdown = %v, want an error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDKey is the metadata key of the request id, taken from the
+// call when the client sets it, and sent back in the response headers.
+const RequestIDKey = "x-request-id"
+
+// requestID returns the request id of the call, a new one when the client
+// sent none.
+func requestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(RequestIDKey); len(v) > 0 && v[0] != "" && len(v[0]) <= 128 {
+		return v[0]
+	}
+	var b [16]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// callLogger returns the logger of a call, with its method, peer, request
+// id and trace id.
+func callLogger(ctx context.Context, l *Logger, fullMethod, id string) *Logger {
+	kv := []interface{}{"method", fullMethod, "request_id", id}
+	if p, ok := peer.FromContext(ctx); ok {
+		kv = append(kv, "peer", p.Addr.String())
+	}
+	if sc := tracing.SpanFromContext(ctx).Context(); sc.IsValid() {
+		kv = append(kv, "trace_id", hex.EncodeToString(sc.TraceID[:]))
+	}
+	return l.With(kv...)
+}
+
+// codeLevel is the level of a call ending with code: errors for the
+// failures of the server, warnings for those of the client.
+func codeLevel(code codes.Code) Level {
+	switch code {
+	case codes.OK:
+		return Info
+	case codes.Unknown, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return Error
+	default:
+		return Warn
+	}
+}
+
+func logEnd(l *Logger, start time.Time, err error, kv ...interface{}) {
+	code := status.Code(err)
+	kv = append([]interface{}{"code", code, "duration_ms", time.Since(start)}, kv...)
+	if err != nil {
+		kv = append(kv, "error", status.Convert(err).Message())
+	}
+	l.Log(codeLevel(code), "finished call", kv...)
+}
+
+// UnaryServerInterceptor logs every call, and the request and response of
+// the methods of p. It is second in the chain, after tracing for the trace
+// id, and before metrics.
+func UnaryServerInterceptor(l *Logger, p *Payloads) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		id := requestID(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, id))
+		cl := callLogger(ctx, l, info.FullMethod, id)
+		logPayloads := p.Enabled(info.FullMethod)
+		if logPayloads {
+			cl.Info("received", "payload", payload(req))
+		}
+		res, err := handler(NewContext(ctx, cl), req)
+		if logPayloads && err == nil {
+			cl.Info("sent", "payload", payload(res))
+		}
+		logEnd(cl, start, err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor logs every call, and each message of the
+// methods of p.
+func StreamServerInterceptor(l *Logger, p *Payloads) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := ss.Context()
+		id := requestID(ctx)
+		ss.SetHeader(metadata.Pairs(RequestIDKey, id))
+		cl := callLogger(ctx, l, info.FullMethod, id)
+		s := &serverStream{ServerStream: ss, ctx: NewContext(ctx, cl), log: cl, method: info.FullMethod, payloads: p}
+		err := handler(srv, s)
+		logEnd(cl, start, err, "messages_received", s.received, "messages_sent", s.sent)
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	log      *Logger
+	method   string
+	payloads *Payloads
+	sent     int
+	received int
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+		if s.payloads.Enabled(s.method) {
+			s.log.Info("sent", "message", s.sent, "payload", payload(m))
+		}
+	}
+	return err
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	switch {
+	case err == nil:
+		s.received++
+		if s.payloads.Enabled(s.method) {
+			s.log.Info("received", "message", s.received, "payload", payload(m))
+		}
+	case err != io.EOF:
+		s.log.Debug("receive failed", "error", err)
+	}
+	return err
+}
@@ -0,0 +1,67 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// control is the state Handler serves and changes.
+type control struct {
+	Level    *string   `json:"level,omitempty"`
+	Payloads *[]string `json:"payloads,omitempty"`
+}
+
+// Handler serves the level of l and the methods of p logging payloads. GET
+// returns them as JSON and PUT changes those set in its JSON body, like
+//
+//	{"level":"debug","payloads":["greet.GreetService/*"]}
+//
+// It has no authentication of its own: mount it on an admin address only
+// operators reach, such as loopback, not next to the public APIs.
+func Handler(l *Logger, p *Payloads) http.Handler {
+	return handler(l, p, true)
+}
+
+// ReadOnlyHandler is Handler rejecting PUT, for the addresses every
+// scraper reaches.
+func ReadOnlyHandler(l *Logger, p *Payloads) http.Handler {
+	return handler(l, p, false)
+}
+
+func handler(l *Logger, p *Payloads, writable bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+		case http.MethodPut:
+			if !writable {
+				http.Error(w, "logging is changed on the admin address", http.StatusForbidden)
+				return
+			}
+			var c control
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<16)).Decode(&c); err != nil {
+				http.Error(w, fmt.Sprintf("malformed settings: %v", err), http.StatusBadRequest)
+				return
+			}
+			if c.Level != nil {
+				level, err := ParseLevel(*c.Level)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				l.SetLevel(level)
+			}
+			if c.Payloads != nil {
+				p.Set(*c.Payloads)
+			}
+			l.Info("changed logging", "level", l.Level(), "payloads", p.List())
+		default:
+			w.Header().Set("Allow", "GET, HEAD, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		level, payloads := l.Level().String(), p.List()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(control{Level: &level, Payloads: &payloads})
+	})
+}
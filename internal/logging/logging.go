@@ -0,0 +1,191 @@
+// Package logging writes levelled logs as JSON lines, one object per
+// event, and logs the gRPC calls of the servers with their method, peer,
+// status, duration, request id and trace id.
+//
+// The calls carry a logger in their context with those fields set, so
+// handlers log through FromContext. Payloads are only logged for the
+// methods it is turned on for, at runtime through Handler, with the fields
+// marked with the (logging.redact) option left out.
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Level is the severity of a log event.
+type Level int32
+
+// Levels, from the most verbose.
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+var levelNames = []string{"debug", "info", "warn", "error"}
+
+func (l Level) String() string {
+	if l < Debug || l > Error {
+		return fmt.Sprintf("level(%d)", int32(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel parses the name of a level, like "info".
+func ParseLevel(s string) (Level, error) {
+	for i, name := range levelNames {
+		if strings.EqualFold(s, name) {
+			return Level(i), nil
+		}
+	}
+	return 0, fmt.Errorf("logging: unknown level %q", s)
+}
+
+// output is shared by a logger and those derived from it.
+type output struct {
+	mu    sync.Mutex
+	w     io.Writer
+	level int32
+}
+
+// Logger writes events with its fields. Derived loggers share the writer
+// and the level.
+type Logger struct {
+	out    *output
+	fields []byte
+}
+
+// New returns a logger writing events of level and above to w.
+func New(w io.Writer, level Level) *Logger {
+	return &Logger{out: &output{w: w, level: int32(level)}}
+}
+
+var std = New(os.Stdout, Info)
+
+// Default returns the logger of code without a logger of its own, writing
+// to stdout.
+func Default() *Logger {
+	return std
+}
+
+// SetLevel changes the level of l and of the loggers sharing its output.
+func (l *Logger) SetLevel(level Level) {
+	atomic.StoreInt32(&l.out.level, int32(level))
+}
+
+// Level returns the level of l.
+func (l *Logger) Level() Level {
+	return Level(atomic.LoadInt32(&l.out.level))
+}
+
+// Enabled tells whether events of level are written.
+func (l *Logger) Enabled(level Level) bool {
+	return level >= l.Level()
+}
+
+// With returns a logger adding the fields, given as key value pairs, to
+// the events.
+func (l *Logger) With(kv ...interface{}) *Logger {
+	fields := make([]byte, len(l.fields), len(l.fields)+32*len(kv)/2)
+	copy(fields, l.fields)
+	return &Logger{out: l.out, fields: appendFields(fields, kv)}
+}
+
+// Debug logs a debugging event.
+func (l *Logger) Debug(msg string, kv ...interface{}) { l.Log(Debug, msg, kv...) }
+
+// Info logs an event of normal operation.
+func (l *Logger) Info(msg string, kv ...interface{}) { l.Log(Info, msg, kv...) }
+
+// Warn logs an event worth a look.
+func (l *Logger) Warn(msg string, kv ...interface{}) { l.Log(Warn, msg, kv...) }
+
+// Error logs a failure.
+func (l *Logger) Error(msg string, kv ...interface{}) { l.Log(Error, msg, kv...) }
+
+// Fatal logs a failure the process cannot go on after, and exits with
+// status 1.
+func (l *Logger) Fatal(msg string, kv ...interface{}) {
+	l.Log(Error, msg, kv...)
+	os.Exit(1)
+}
+
+// Log logs an event of level with the fields of l and kv.
+func (l *Logger) Log(level Level, msg string, kv ...interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+	b := make([]byte, 0, 256)
+	b = append(b, `{"time":`...)
+	b = appendValue(b, time.Now().UTC().Format(time.RFC3339Nano))
+	b = append(b, `,"level":`...)
+	b = appendValue(b, level.String())
+	b = append(b, `,"msg":`...)
+	b = appendValue(b, msg)
+	b = append(b, l.fields...)
+	b = appendFields(b, kv)
+	b = append(b, "}\n"...)
+	l.out.mu.Lock()
+	l.out.w.Write(b)
+	l.out.mu.Unlock()
+}
+
+func appendFields(b []byte, kv []interface{}) []byte {
+	for i := 0; i < len(kv); i += 2 {
+		key, ok := kv[i].(string)
+		if !ok {
+			key = fmt.Sprint(kv[i])
+		}
+		var value interface{} = "(missing)"
+		if i+1 < len(kv) {
+			value = kv[i+1]
+		}
+		b = append(b, ',')
+		b = appendValue(b, key)
+		b = append(b, ':')
+		b = appendValue(b, value)
+	}
+	return b
+}
+
+func appendValue(b []byte, v interface{}) []byte {
+	switch v := v.(type) {
+	case json.Marshaler:
+		// encoded as itself below, even when it is a Stringer too
+	case error:
+		return appendValue(b, v.Error())
+	case time.Duration:
+		return appendValue(b, float64(v)/float64(time.Millisecond))
+	case fmt.Stringer:
+		return appendValue(b, v.String())
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		data, _ = json.Marshal(fmt.Sprint(v))
+	}
+	return append(b, data...)
+}
+
+type loggerKey struct{}
+
+// NewContext returns a context carrying l.
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext returns the logger of ctx, the default one if there is none.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*Logger); ok {
+		return l
+	}
+	return std
+}
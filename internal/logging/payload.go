@@ -0,0 +1,150 @@
+package logging
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging/logpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Payloads are the methods whose payloads are logged, safe for concurrent
+// use. A method is given as service/method, like greet.GreetService/Greet,
+// service/* for all the methods of a service, or * for every method.
+type Payloads struct {
+	mu       sync.RWMutex
+	patterns map[string]bool
+}
+
+// NewPayloads returns the payloads of the methods matching patterns.
+func NewPayloads(patterns ...string) *Payloads {
+	p := &Payloads{}
+	p.Set(patterns)
+	return p
+}
+
+// Set replaces the methods whose payloads are logged.
+func (p *Payloads) Set(patterns []string) {
+	m := make(map[string]bool, len(patterns))
+	for _, pattern := range patterns {
+		if pattern = strings.TrimPrefix(strings.TrimSpace(pattern), "/"); pattern != "" {
+			m[pattern] = true
+		}
+	}
+	p.mu.Lock()
+	p.patterns = m
+	p.mu.Unlock()
+}
+
+// List returns the patterns of the methods whose payloads are logged.
+func (p *Payloads) List() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	list := make([]string, 0, len(p.patterns))
+	for pattern := range p.patterns {
+		list = append(list, pattern)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// Enabled tells whether the payloads of fullMethod, like
+// /greet.GreetService/Greet, are logged.
+func (p *Payloads) Enabled(fullMethod string) bool {
+	if p == nil {
+		return false
+	}
+	name := strings.TrimPrefix(fullMethod, "/")
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.patterns) == 0 {
+		return false
+	}
+	if p.patterns["*"] || p.patterns[name] {
+		return true
+	}
+	i := strings.LastIndex(name, "/")
+	return i >= 0 && p.patterns[name[:i]+"/*"]
+}
+
+// redactedValue replaces the strings of redacted fields.
+const redactedValue = "[REDACTED]"
+
+var redactedFields sync.Map // protoreflect.FieldDescriptor -> bool
+
+func isRedacted(fd protoreflect.FieldDescriptor) bool {
+	if v, ok := redactedFields.Load(fd); ok {
+		return v.(bool)
+	}
+	redacted := false
+	if opts := fd.Options(); opts != nil {
+		redacted, _ = proto.GetExtension(opts, logpb.E_Redact).(bool)
+	}
+	redactedFields.Store(fd, redacted)
+	return redacted
+}
+
+// Redact returns a copy of m without the values of the fields marked with
+// the (logging.redact) option: their strings read [REDACTED], other
+// values are cleared.
+func Redact(m proto.Message) proto.Message {
+	m = proto.Clone(m)
+	redact(m.ProtoReflect())
+	return m
+}
+
+func redact(m protoreflect.Message) {
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+	for _, fd := range fields {
+		v := m.Get(fd)
+		switch {
+		case isRedacted(fd):
+			switch {
+			case fd.IsList() && fd.Kind() == protoreflect.StringKind:
+				for l, i := v.List(), 0; i < l.Len(); i++ {
+					l.Set(i, protoreflect.ValueOfString(redactedValue))
+				}
+			case !fd.IsList() && !fd.IsMap() && fd.Kind() == protoreflect.StringKind:
+				m.Set(fd, protoreflect.ValueOfString(redactedValue))
+			default:
+				m.Clear(fd)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redact(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				for l, i := v.List(), 0; i < l.Len(); i++ {
+					redact(l.Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			redact(v.Message())
+		}
+	}
+}
+
+// payload returns the redacted JSON of a message, to log as a field.
+func payload(m interface{}) interface{} {
+	pm, ok := m.(proto.Message)
+	if !ok {
+		return m
+	}
+	data, err := protojson.Marshal(Redact(pm))
+	if err != nil {
+		return err.Error()
+	}
+	return json.RawMessage(data)
+}
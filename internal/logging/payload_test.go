@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"testing"
+
+	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	"github.com/dipjyotimetia/gogrpc/greet/greetpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name string
+		in   proto.Message
+		want proto.Message
+	}{
+		{
+			name: "nested greeting",
+			in:   &greetpb.GreetRequest{Greeting: &greetpb.Greeting{FirstName: "Ada", LastName: "Lovelace"}},
+			want: &greetpb.GreetRequest{Greeting: &greetpb.Greeting{FirstName: redactedValue, LastName: redactedValue}},
+		},
+		{
+			name: "repeated strings",
+			in:   &greetpb.LongGreetResponse{Result: "Hello Ada", Locale: "en", Count: 2, DistinctNames: []string{"Ada", "Alan"}},
+			want: &greetpb.LongGreetResponse{Result: redactedValue, Locale: "en", Count: 2, DistinctNames: []string{redactedValue, redactedValue}},
+		},
+		{
+			name: "blog keeps ids and tags",
+			in:   &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: "a1", Title: "Diary", Content: "Dear diary", Tags: []string{"go"}}},
+			want: &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: "a1", Title: redactedValue, Content: redactedValue, Tags: []string{"go"}}},
+		},
+		{
+			name: "empty fields stay empty",
+			in:   &blogpb.Author{Id: "a1", DisplayName: "Ada"},
+			want: &blogpb.Author{Id: "a1", DisplayName: redactedValue},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := proto.Clone(tt.in)
+			if got := Redact(tt.in); !proto.Equal(got, tt.want) {
+				t.Errorf("Redact = %v, want %v", got, tt.want)
+			}
+			if !proto.Equal(tt.in, before) {
+				t.Errorf("Redact changed its argument to %v", tt.in)
+			}
+		})
+	}
+}
+
+func TestPayloadsEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		method   string
+		want     bool
+	}{
+		{"none", nil, "/greet.GreetService/Greet", false},
+		{"method", []string{"greet.GreetService/Greet"}, "/greet.GreetService/Greet", true},
+		{"other method", []string{"greet.GreetService/Greet"}, "/greet.GreetService/LongGreet", false},
+		{"leading slash and spaces", []string{" /greet.GreetService/Greet "}, "/greet.GreetService/Greet", true},
+		{"service", []string{"greet.GreetService/*"}, "/greet.GreetService/LongGreet", true},
+		{"other service", []string{"greet.GreetService/*"}, "/blog.BlogService/CreateBlog", false},
+		{"every method", []string{"*"}, "/blog.BlogService/CreateBlog", true},
+		{"blank patterns", []string{"", " "}, "/greet.GreetService/Greet", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPayloads(tt.patterns...).Enabled(tt.method); got != tt.want {
+				t.Errorf("Enabled(%q) with %q = %v, want %v", tt.method, tt.patterns, got, tt.want)
+			}
+		})
+	}
+
+	var p *Payloads
+	if p.Enabled("/greet.GreetService/Greet") {
+		t.Error("nil Payloads enabled")
+	}
+}
@@ -0,0 +1,189 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPC records the calls of the gRPC servers, or clients, of a process:
+// the calls started and handled by method and code, their latency, the
+// calls in flight and the messages of the streams.
+type RPC struct {
+	started  *Counter
+	handled  *Counter
+	seconds  *Histogram
+	inFlight *Gauge
+	received *Counter
+	sent     *Counter
+}
+
+// NewServerRPC registers the grpc_server_* metrics.
+func NewServerRPC(r *Registry) *RPC {
+	return newRPC(r, "server")
+}
+
+// NewClientRPC registers the grpc_client_* metrics.
+func NewClientRPC(r *Registry) *RPC {
+	return newRPC(r, "client")
+}
+
+func newRPC(r *Registry, side string) *RPC {
+	labels := []string{"grpc_type", "grpc_service", "grpc_method"}
+	prefix := "grpc_" + side + "_"
+	return &RPC{
+		started:  r.NewCounter(prefix+"started_total", "Calls started.", labels...),
+		handled:  r.NewCounter(prefix+"handled_total", "Calls completed, by status code.", append(labels, "grpc_code")...),
+		seconds:  r.NewHistogram(prefix+"handling_seconds", "Duration of the calls until their status.", DefaultBuckets, labels...),
+		inFlight: r.NewGauge(prefix+"in_flight", "Calls started and not completed.", labels...),
+		received: r.NewCounter(prefix+"msg_received_total", "Stream messages received.", labels...),
+		sent:     r.NewCounter(prefix+"msg_sent_total", "Stream messages sent.", labels...),
+	}
+}
+
+// call is one recorded call.
+type call struct {
+	rpc    *RPC
+	labels []string
+	start  time.Time
+	once   sync.Once
+}
+
+func (m *RPC) start(typ, fullMethod string) *call {
+	service, method := splitMethod(fullMethod)
+	c := &call{rpc: m, labels: []string{typ, service, method}, start: time.Now()}
+	m.started.Inc(c.labels...)
+	m.inFlight.Inc(c.labels...)
+	return c
+}
+
+func (c *call) done(err error) {
+	c.once.Do(func() {
+		c.rpc.inFlight.Dec(c.labels...)
+		c.rpc.seconds.Observe(time.Since(c.start).Seconds(), c.labels...)
+		c.rpc.handled.Inc(append(c.labels, status.Code(err).String())...)
+	})
+}
+
+func splitMethod(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+func streamType(clientStreams, serverStreams bool) string {
+	switch {
+	case clientStreams && serverStreams:
+		return "bidi_stream"
+	case clientStreams:
+		return "client_stream"
+	case serverStreams:
+		return "server_stream"
+	}
+	return "unary"
+}
+
+// UnaryServerInterceptor records unary calls. It is third in the chain,
+// after logging and before rpcerr, so it sees the codes sent.
+func (m *RPC) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		c := m.start("unary", info.FullMethod)
+		res, err := handler(ctx, req)
+		c.done(err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor records streaming calls and their messages.
+func (m *RPC) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		c := m.start(streamType(info.IsClientStream, info.IsServerStream), info.FullMethod)
+		err := handler(srv, &serverStream{ServerStream: ss, call: c})
+		c.done(err)
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	call *call
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.call.rpc.sent.Inc(s.call.labels...)
+	}
+	return err
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.call.rpc.received.Inc(s.call.labels...)
+	}
+	return err
+}
+
+// UnaryClientInterceptor records unary calls.
+func (m *RPC) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		c := m.start("unary", method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		c.done(err)
+		return err
+	}
+}
+
+// StreamClientInterceptor records streaming calls and their messages. A
+// call completes when its last message or its error is received.
+func (m *RPC) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		c := m.start(streamType(desc.ClientStreams, desc.ServerStreams), method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			c.done(err)
+			return nil, err
+		}
+		return &clientStream{ClientStream: cs, call: c, serverStreams: desc.ServerStreams}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	call          *call
+	serverStreams bool
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err == nil {
+		s.call.rpc.sent.Inc(s.call.labels...)
+	}
+	return err
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == nil:
+		s.call.rpc.received.Inc(s.call.labels...)
+		// without a response stream, the single response ends the call
+		if !s.serverStreams {
+			s.call.done(nil)
+		}
+	case errors.Is(err, io.EOF):
+		s.call.done(nil)
+	default:
+		s.call.done(err)
+	}
+	return err
+}
@@ -0,0 +1,239 @@
+// Package metrics records Prometheus metrics and serves them on /metrics.
+//
+// Counters, gauges and histograms are kept in a Registry, one series per
+// set of label values, and rendered with the exposition formats of
+// prometheus/common. Interceptors record the calls of gRPC servers and
+// clients.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of latency histograms.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Registry holds the metrics of a process.
+type Registry struct {
+	mu      sync.Mutex
+	metrics map[string]*metric
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{metrics: map[string]*metric{}}
+}
+
+type metric struct {
+	name    string
+	help    string
+	typ     dto.MetricType
+	labels  []string
+	buckets []float64
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+type series struct {
+	labels []string
+	value  float64
+	// counts of the observations per bucket, the last one for +Inf
+	counts []uint64
+	count  uint64
+}
+
+func (r *Registry) add(name, help string, typ dto.MetricType, buckets []float64, labels []string) *metric {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.metrics[name]; ok {
+		panic(fmt.Sprintf("metrics: %s registered twice", name))
+	}
+	m := &metric{name: name, help: help, typ: typ, labels: labels, buckets: buckets, series: map[string]*series{}}
+	r.metrics[name] = m
+	return m
+}
+
+// with returns the series of the label values, m.mu held.
+func (m *metric) with(values []string) *series {
+	if len(values) != len(m.labels) {
+		panic(fmt.Sprintf("metrics: %s has labels %v, got values %v", m.name, m.labels, values))
+	}
+	key := strings.Join(values, "\xff")
+	s, ok := m.series[key]
+	if !ok {
+		s = &series{labels: append([]string(nil), values...)}
+		if m.typ == dto.MetricType_HISTOGRAM {
+			s.counts = make([]uint64, len(m.buckets)+1)
+		}
+		m.series[key] = s
+	}
+	return s
+}
+
+// Counter is a value that only goes up, like a number of calls.
+type Counter struct{ m *metric }
+
+// NewCounter registers a counter with the label names.
+func (r *Registry) NewCounter(name, help string, labels ...string) *Counter {
+	return &Counter{r.add(name, help, dto.MetricType_COUNTER, nil, labels)}
+}
+
+// Inc adds one to the series of the label values.
+func (c *Counter) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Add adds v, which must not be negative, to the series of the label
+// values.
+func (c *Counter) Add(v float64, values ...string) {
+	c.m.mu.Lock()
+	c.m.with(values).value += v
+	c.m.mu.Unlock()
+}
+
+// Gauge is a value that goes up and down, like a number of running calls.
+type Gauge struct{ m *metric }
+
+// NewGauge registers a gauge with the label names.
+func (r *Registry) NewGauge(name, help string, labels ...string) *Gauge {
+	return &Gauge{r.add(name, help, dto.MetricType_GAUGE, nil, labels)}
+}
+
+// Add adds v to the series of the label values.
+func (g *Gauge) Add(v float64, values ...string) {
+	g.m.mu.Lock()
+	g.m.with(values).value += v
+	g.m.mu.Unlock()
+}
+
+// Inc adds one to the series of the label values.
+func (g *Gauge) Inc(values ...string) { g.Add(1, values...) }
+
+// Dec subtracts one from the series of the label values.
+func (g *Gauge) Dec(values ...string) { g.Add(-1, values...) }
+
+// Histogram counts observations, like latencies, in buckets.
+type Histogram struct{ m *metric }
+
+// NewHistogram registers a histogram with the bucket upper bounds, in
+// increasing order, and the label names.
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	return &Histogram{r.add(name, help, dto.MetricType_HISTOGRAM, buckets, labels)}
+}
+
+// Observe adds v to the series of the label values.
+func (h *Histogram) Observe(v float64, values ...string) {
+	i := sort.SearchFloat64s(h.m.buckets, v)
+	h.m.mu.Lock()
+	s := h.m.with(values)
+	s.counts[i]++
+	s.count++
+	s.value += v
+	h.m.mu.Unlock()
+}
+
+// Gather returns the metric families, sorted by name.
+func (r *Registry) Gather() []*dto.MetricFamily {
+	r.mu.Lock()
+	metrics := make([]*metric, 0, len(r.metrics))
+	for _, m := range r.metrics {
+		metrics = append(metrics, m)
+	}
+	r.mu.Unlock()
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].name < metrics[j].name })
+
+	families := make([]*dto.MetricFamily, 0, len(metrics))
+	for _, m := range metrics {
+		if mf := m.family(); len(mf.Metric) > 0 {
+			families = append(families, mf)
+		}
+	}
+	return families
+}
+
+func (m *metric) family() *dto.MetricFamily {
+	typ := m.typ
+	mf := &dto.MetricFamily{Name: &m.name, Help: &m.help, Type: &typ}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := make([]string, 0, len(m.series))
+	for k := range m.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := m.series[k]
+		pb := &dto.Metric{}
+		for i, name := range m.labels {
+			pb.Label = append(pb.Label, &dto.LabelPair{Name: stringPtr(name), Value: stringPtr(s.labels[i])})
+		}
+		switch m.typ {
+		case dto.MetricType_COUNTER:
+			pb.Counter = &dto.Counter{Value: floatPtr(s.value)}
+		case dto.MetricType_GAUGE:
+			pb.Gauge = &dto.Gauge{Value: floatPtr(s.value)}
+		case dto.MetricType_HISTOGRAM:
+			h := &dto.Histogram{SampleCount: uintPtr(s.count), SampleSum: floatPtr(s.value)}
+			var cumulative uint64
+			for i, bound := range m.buckets {
+				cumulative += s.counts[i]
+				h.Bucket = append(h.Bucket, &dto.Bucket{CumulativeCount: uintPtr(cumulative), UpperBound: floatPtr(bound)})
+			}
+			h.Bucket = append(h.Bucket, &dto.Bucket{CumulativeCount: uintPtr(s.count), UpperBound: floatPtr(math.Inf(1))})
+			pb.Histogram = h
+		}
+		mf.Metric = append(mf.Metric, pb)
+	}
+	return mf
+}
+
+func stringPtr(s string) *string  { return &s }
+func floatPtr(f float64) *float64 { return &f }
+func uintPtr(n uint64) *uint64    { return &n }
+
+// ServeHTTP writes the metrics in the format the scraper accepts.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	format := expfmt.Negotiate(req.Header)
+	w.Header().Set("Content-Type", string(format))
+	enc := expfmt.NewEncoder(w, format)
+	for _, mf := range r.Gather() {
+		if err := enc.Encode(mf); err != nil {
+			return
+		}
+	}
+	if c, ok := enc.(expfmt.Closer); ok {
+		c.Close()
+	}
+}
+
+// WriteText writes the metrics in the Prometheus text format.
+func (r *Registry) WriteText(w io.Writer) error {
+	for _, mf := range r.Gather() {
+		if _, err := expfmt.MetricFamilyToText(w, mf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewServer returns an HTTP server serving the metrics of r on
+// addr/metrics, and the operator endpoints of handlers, by path, next to
+// them.
+func NewServer(addr string, r *Registry, handlers map[string]http.Handler) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", r)
+	for path, h := range handlers {
+		mux.Handle(path, h)
+	}
+	return &http.Server{Addr: addr, Handler: mux}
+}
@@ -0,0 +1,148 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExposition(t *testing.T) {
+	tests := []struct {
+		name   string
+		record func(r *Registry)
+		want   string
+	}{
+		{
+			name: "counter by label",
+			record: func(r *Registry) {
+				c := r.NewCounter("calls_total", "Calls.", "method")
+				c.Inc("Greet")
+				c.Add(2, "Greet")
+				c.Inc(`Sum"\`)
+			},
+			want: `# HELP calls_total Calls.
+# TYPE calls_total counter
+calls_total{method="Greet"} 3
+calls_total{method="Sum\"\\"} 1
+`,
+		},
+		{
+			name: "gauge without labels",
+			record: func(r *Registry) {
+				g := r.NewGauge("in_flight", "Running calls.")
+				g.Inc()
+				g.Inc()
+				g.Dec()
+			},
+			want: `# HELP in_flight Running calls.
+# TYPE in_flight gauge
+in_flight 1
+`,
+		},
+		{
+			name: "histogram buckets are cumulative and inclusive",
+			record: func(r *Registry) {
+				h := r.NewHistogram("latency_seconds", "Latency.", []float64{1, 2})
+				for _, v := range []float64{0.5, 1, 1.5, 3} {
+					h.Observe(v)
+				}
+			},
+			want: `# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="1"} 2
+latency_seconds_bucket{le="2"} 3
+latency_seconds_bucket{le="+Inf"} 4
+latency_seconds_sum 6
+latency_seconds_count 4
+`,
+		},
+		{
+			name: "families sorted, unused ones left out",
+			record: func(r *Registry) {
+				r.NewCounter("b_total", "B.").Inc()
+				r.NewCounter("unused_total", "Never incremented.")
+				r.NewCounter("a_total", "A.").Inc()
+			},
+			want: `# HELP a_total A.
+# TYPE a_total counter
+a_total 1
+# HELP b_total B.
+# TYPE b_total counter
+b_total 1
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry()
+			tt.record(r)
+			var buf bytes.Buffer
+			if err := r.WriteText(&buf); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestServeHTTPNegotiation(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("calls_total", "Calls.").Inc()
+	tests := []struct {
+		accept string
+		want   string // content type prefix
+	}{
+		{"", "text/plain; version=0.0.4"},
+		{"text/plain", "text/plain; version=0.0.4"},
+		{"application/vnd.google.protobuf;proto=io.prometheus.client.MetricFamily;encoding=delimited", "application/vnd.google.protobuf"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if tt.accept != "" {
+			req.Header.Set("Accept", tt.accept)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, tt.want) {
+			t.Errorf("Accept %q: Content-Type = %q, want %q", tt.accept, ct, tt.want)
+		}
+		if w.Body.Len() == 0 {
+			t.Errorf("Accept %q: empty body", tt.accept)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	r := NewRegistry()
+	intercept := NewServerRPC(r).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/greet.GreetService/Greet"}
+	for _, err := range []error{nil, status.Error(codes.NotFound, "no"), nil} {
+		intercept(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) { return nil, err })
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	labels := `grpc_type="unary",grpc_service="greet.GreetService",grpc_method="Greet"`
+	for _, want := range []string{
+		`grpc_server_started_total{` + labels + `} 3`,
+		`grpc_server_handled_total{` + labels + `,grpc_code="OK"} 2`,
+		`grpc_server_handled_total{` + labels + `,grpc_code="NotFound"} 1`,
+		`grpc_server_in_flight{` + labels + `} 0`,
+		`grpc_server_handling_seconds_count{` + labels + `} 3`,
+	} {
+		if !strings.Contains(buf.String(), want+"\n") {
+			t.Errorf("missing %s in\n%s", want, buf.String())
+		}
+	}
+}
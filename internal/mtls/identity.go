@@ -0,0 +1,203 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+
+	"github.com/dipjyotimetia/gogrpc/internal/inprocess"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ForwardedCertHeader carries the DER certificate of the client of an HTTP
+// front end to the in-process server.
+const ForwardedCertHeader = "x-forwarded-client-cert-bin"
+
+// Identity is who a client certificate was issued to.
+type Identity struct {
+	// SPIFFEID is the spiffe:// URI SAN, if any.
+	SPIFFEID   string
+	DNSNames   []string
+	CommonName string
+}
+
+// Principal names the client: its SPIFFE ID, else its first DNS name,
+// else its common name.
+func (id Identity) Principal() string {
+	switch {
+	case id.SPIFFEID != "":
+		return id.SPIFFEID
+	case len(id.DNSNames) > 0:
+		return id.DNSNames[0]
+	}
+	return id.CommonName
+}
+
+func (id Identity) String() string {
+	return id.Principal()
+}
+
+// FromCertificate returns the identity of a client certificate.
+func FromCertificate(cert *x509.Certificate) Identity {
+	id := Identity{DNSNames: cert.DNSNames, CommonName: cert.Subject.CommonName}
+	for _, u := range cert.URIs {
+		if u.Scheme == "spiffe" {
+			id.SPIFFEID = u.String()
+			break
+		}
+	}
+	return id
+}
+
+type identityKey struct{}
+
+// NewContext returns ctx carrying id.
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity of the caller, when it presented a
+// certificate.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// verifiedLeaf returns the client certificate of a handshake, when it was
+// verified.
+func verifiedLeaf(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
+
+// Forward sets the ForwardedCertHeader of md for a call made on behalf of
+// an HTTP client. Any value sent by the client itself is dropped first.
+func Forward(md metadata.MD, state *tls.ConnectionState) {
+	md.Delete(ForwardedCertHeader)
+	if leaf := verifiedLeaf(state); leaf != nil {
+		md.Set(ForwardedCertHeader, string(leaf.Raw))
+	}
+}
+
+// withIdentity adds the identity of the caller to ctx: the certificate it
+// presented, or the one forwarded by an in-process front end.
+func withIdentity(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		if leaf := verifiedLeaf(&info.State); leaf != nil {
+			return NewContext(ctx, FromCertificate(leaf))
+		}
+		return ctx
+	}
+	if p.Addr == nil || p.Addr.Network() != inprocess.Network {
+		return ctx
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if raw := md.Get(ForwardedCertHeader); len(raw) > 0 {
+		if cert, err := x509.ParseCertificate([]byte(raw[0])); err == nil {
+			return NewContext(ctx, FromCertificate(cert))
+		}
+	}
+	return ctx
+}
+
+// Reasons of the errors of restricted services.
+const (
+	ReasonCertificateRequired = "CLIENT_CERTIFICATE_REQUIRED"
+	ReasonNotAllowed          = "CALLER_NOT_ALLOWED"
+)
+
+// Option configures the interceptors.
+type Option func(restrictions)
+
+// restrictions are the principals allowed to call each restricted service.
+type restrictions map[string]map[string]bool
+
+// Restrict reserves service, by its full name such as
+// "blog.BlogAdminService", to the callers whose principal is one of
+// principals. Without principals, nobody can call it.
+func Restrict(service string, principals ...string) Option {
+	return func(r restrictions) {
+		allowed := map[string]bool{}
+		for _, p := range principals {
+			allowed[p] = true
+		}
+		r[service] = allowed
+	}
+}
+
+// authorize checks the caller in ctx may call method.
+func (r restrictions) authorize(ctx context.Context, method string) error {
+	service := strings.TrimPrefix(method, "/")
+	if i := strings.LastIndex(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	allowed, ok := r[service]
+	if !ok {
+		return nil
+	}
+	id, ok := FromContext(ctx)
+	if !ok {
+		return &rpcerr.Error{Code: codes.Unauthenticated, Reason: ReasonCertificateRequired, Message: service + " requires a client certificate"}
+	}
+	if !allowed[id.Principal()] {
+		return &rpcerr.Error{Code: codes.PermissionDenied, Reason: ReasonNotAllowed, Message: id.Principal() + " may not call " + service}
+	}
+	return nil
+}
+
+func newRestrictions(opts []Option) restrictions {
+	r := restrictions{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// UnaryServerInterceptor puts the identity of the caller in the context of
+// handlers and of the interceptors after it, and rejects the callers of
+// the services restricted by opts. It is fifth in the chain, after rpcerr
+// and before deadline.
+func UnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+	r := newRestrictions(opts)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withIdentity(ctx)
+		if err := r.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(opts ...Option) grpc.StreamServerInterceptor {
+	r := newRestrictions(opts)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withIdentity(ss.Context())
+		if err := r.authorize(ctx, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,204 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/inprocess"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type issued struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// issue signs tmpl with parent, or self-signs it when parent is nil.
+func issue(t *testing.T, tmpl *x509.Certificate, parent *issued) *issued {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	if tmpl.NotBefore.IsZero() {
+		tmpl.NotBefore = time.Now().Add(-time.Hour)
+	}
+	if tmpl.NotAfter.IsZero() {
+		tmpl.NotAfter = time.Now().Add(24 * time.Hour)
+	}
+	signer, signerKey := tmpl, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &issued{cert: cert, key: key}
+}
+
+func TestPrincipal(t *testing.T) {
+	spiffe, _ := url.Parse("spiffe://example.org/ns/blog/sa/writer")
+	other, _ := url.Parse("https://example.org/writer")
+	tests := []struct {
+		name string
+		tmpl *x509.Certificate
+		want string
+	}{
+		{"spiffe id first", &x509.Certificate{Subject: pkix.Name{CommonName: "writer"}, DNSNames: []string{"writer.local"}, URIs: []*url.URL{other, spiffe}}, spiffe.String()},
+		{"then dns name", &x509.Certificate{Subject: pkix.Name{CommonName: "writer"}, DNSNames: []string{"writer.local", "w.local"}, URIs: []*url.URL{other}}, "writer.local"},
+		{"then common name", &x509.Certificate{Subject: pkix.Name{CommonName: "writer"}}, "writer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := FromCertificate(issue(t, tt.tmpl, nil).cert)
+			if got := id.Principal(); got != tt.want {
+				t.Errorf("Principal = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type addr string
+
+func (a addr) Network() string { return string(a) }
+func (a addr) String() string  { return "client" }
+
+func TestIdentitySpoofing(t *testing.T) {
+	ca := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "ca"}, IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil)
+	client := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}, ca)
+	admin := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "admin"}}, ca)
+
+	verified := credentials.TLSInfo{State: tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{client.cert},
+		VerifiedChains:   [][]*x509.Certificate{{client.cert, ca.cert}},
+	}}
+	unverified := credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{client.cert}}}
+	forwarded := metadata.Pairs(ForwardedCertHeader, string(admin.cert.Raw))
+
+	tests := []struct {
+		name string
+		peer *peer.Peer
+		md   metadata.MD
+		want string // principal, empty for no identity
+	}{
+		{"no peer", nil, forwarded, ""},
+		{"verified certificate", &peer.Peer{Addr: addr("tcp"), AuthInfo: verified}, nil, "client"},
+		{"header next to a verified certificate", &peer.Peer{Addr: addr("tcp"), AuthInfo: verified}, forwarded, "client"},
+		{"header next to an unverified certificate", &peer.Peer{Addr: addr("tcp"), AuthInfo: unverified}, forwarded, ""},
+		{"header over plaintext tcp", &peer.Peer{Addr: addr("tcp")}, forwarded, ""},
+		{"header from a front end", &peer.Peer{Addr: addr(inprocess.Network)}, forwarded, "admin"},
+		{"front end without a client certificate", &peer.Peer{Addr: addr(inprocess.Network)}, nil, ""},
+		{"garbage header", &peer.Peer{Addr: addr(inprocess.Network)}, metadata.Pairs(ForwardedCertHeader, "admin"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, tt.peer)
+			}
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			id, ok := FromContext(withIdentity(ctx))
+			if got := id.Principal(); ok != (tt.want != "") || got != tt.want {
+				t.Errorf("identity = %q, %v, want %q", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestForward(t *testing.T) {
+	ca := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "ca"}, IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil)
+	client := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}, ca)
+
+	tests := []struct {
+		name  string
+		state *tls.ConnectionState
+		want  []string
+	}{
+		{"plain http", nil, nil},
+		{"unverified", &tls.ConnectionState{PeerCertificates: []*x509.Certificate{client.cert}}, nil},
+		{"verified", &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{client.cert, ca.cert}}}, []string{string(client.cert.Raw)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// whatever the browser sent is never passed on
+			md := metadata.Pairs(ForwardedCertHeader, "spoofed", ForwardedCertHeader, "again")
+			Forward(md, tt.state)
+			got := md.Get(ForwardedCertHeader)
+			if len(got) != len(tt.want) || (len(got) == 1 && got[0] != tt.want[0]) {
+				t.Errorf("forwarded %d values, want %d", len(got), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestRestrict(t *testing.T) {
+	ca := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "ca"}, IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil)
+	caller := func(name string) *peer.Peer {
+		cert := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: name}}, ca).cert
+		return &peer.Peer{Addr: addr("tcp"), AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert, ca.cert}}}}}
+	}
+	admin, writer := caller("admin"), caller("writer")
+	interceptor := UnaryServerInterceptor(Restrict("blog.BlogAdminService", "admin"), Restrict("ops.OpsService"))
+
+	tests := []struct {
+		name   string
+		peer   *peer.Peer
+		method string
+		want   codes.Code
+	}{
+		{"open service", nil, "/blog.BlogService/ReadBlog", codes.OK},
+		{"admin", admin, "/blog.BlogAdminService/EraseAuthorData", codes.OK},
+		{"other principal", writer, "/blog.BlogAdminService/EraseAuthorData", codes.PermissionDenied},
+		{"no certificate", &peer.Peer{Addr: addr("tcp")}, "/blog.BlogAdminService/ExportAuthorData", codes.Unauthenticated},
+		{"no principals", admin, "/ops.OpsService/Drain", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, tt.peer)
+			}
+			called := false
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})
+			if tt.want == codes.OK {
+				if err != nil || !called {
+					t.Errorf("error = %v, called %v", err, called)
+				}
+				return
+			}
+			var e *rpcerr.Error
+			if !errors.As(err, &e) || e.Code != tt.want {
+				t.Errorf("error = %v, want %v", err, tt.want)
+			}
+			if called {
+				t.Error("handler called")
+			}
+		})
+	}
+}
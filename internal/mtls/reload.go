@@ -0,0 +1,236 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+)
+
+// expiryWarning is how long before its expiry the active certificate is
+// reported at each check, and how often.
+const (
+	expiryWarning      = 7 * 24 * time.Hour
+	expiryWarningEvery = time.Hour
+)
+
+// Reloader serves the certificate and client CA bundle of a server from
+// files that may be replaced while it runs, as rotation agents do.
+//
+// Watch polls the files and loads them again when they change. New
+// material is checked before it replaces the active one: the key must
+// match the certificate, the certificate must be valid now, and the CA
+// bundle must hold at least one certificate. On any error the server keeps
+// the material it has and the error is logged. At start up there is no
+// material to keep, so a certificate outside its validity period is served
+// with a warning.
+type Reloader struct {
+	certFile, keyFile, caFile string
+	log                       *logging.Logger
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+	stamps   []stamp
+	failed   []stamp
+	warned   time.Time
+}
+
+// stamp identifies a version of a file.
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewReloader loads the certificate and key files, and the client CA
+// bundle when caFile is set, which makes the server require client
+// certificates. What it loads and the errors it keeps serving through are
+// logged to log.
+func NewReloader(certFile, keyFile, caFile string, log *logging.Logger) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile, caFile: caFile, log: log}
+	if err := r.load(false); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.caFile != "" {
+		files = append(files, r.caFile)
+	}
+	return files
+}
+
+func (r *Reloader) stat() ([]stamp, error) {
+	var stamps []stamp
+	for _, f := range r.files() {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		stamps = append(stamps, stamp{modTime: fi.ModTime(), size: fi.Size()})
+	}
+	return stamps, nil
+}
+
+// Reload loads the files, keeping the active material when they are not
+// valid.
+func (r *Reloader) Reload() error {
+	return r.load(true)
+}
+
+func (r *Reloader) load(strict bool) error {
+	stamps, err := r.stat()
+	if err != nil {
+		return fmt.Errorf("mtls: %w", err)
+	}
+	cert, err := loadCertificate(r.certFile, r.keyFile)
+	if err == nil {
+		if verr := checkValidity(r.certFile, cert.Leaf); verr != nil {
+			if strict {
+				err = verr
+			} else {
+				r.log.Warn("serving a certificate outside its validity period", "error", verr)
+			}
+		}
+	}
+	var pool *x509.CertPool
+	if err == nil && r.caFile != "" {
+		pool, err = loadPool(r.caFile)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.failed = stamps
+		return err
+	}
+	r.cert, r.clientCA, r.stamps, r.failed = cert, pool, stamps, nil
+	r.log.Info("serving certificate", describe(r.certFile, cert.Leaf)...)
+	return nil
+}
+
+// loadCertificate loads a key pair, checking the key matches.
+func loadCertificate(certFile, keyFile string) (*tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("mtls: server certificate: %w", err)
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return nil, fmt.Errorf("mtls: server certificate: %w", err)
+	}
+	return &cert, nil
+}
+
+func checkValidity(certFile string, leaf *x509.Certificate) error {
+	now := time.Now()
+	if now.Before(leaf.NotBefore) {
+		return fmt.Errorf("mtls: server certificate %s is not valid before %s", certFile, leaf.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(leaf.NotAfter) {
+		return fmt.Errorf("mtls: server certificate %s expired on %s", certFile, leaf.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func describe(certFile string, leaf *x509.Certificate) []interface{} {
+	return []interface{}{"file", certFile, "subject", leaf.Subject.CommonName, "expires", leaf.NotAfter.Format(time.RFC3339), "expires_in", time.Until(leaf.NotAfter).Round(time.Minute).String()}
+}
+
+// Watch checks the files every interval until ctx is done, reloading them
+// when they change, and reports the expiry of the active certificate when
+// it comes close.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		r.check()
+	}
+}
+
+func (r *Reloader) check() {
+	stamps, err := r.stat()
+	if err != nil {
+		// files being replaced, or gone: keep serving, check again later
+		r.log.Warn("keeping the active certificate", "error", err)
+		return
+	}
+	r.mu.RLock()
+	changed := !sameStamps(stamps, r.stamps) && !sameStamps(stamps, r.failed)
+	r.mu.RUnlock()
+	if changed {
+		if err := r.Reload(); err != nil {
+			r.log.Warn("keeping the active certificate", "error", err)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	if r.cert.Leaf.NotAfter.Sub(now) < expiryWarning && now.Sub(r.warned) >= expiryWarningEvery {
+		r.warned = now
+		r.log.Warn("certificate expires soon", describe(r.certFile, r.cert.Leaf)...)
+	}
+}
+
+func sameStamps(a, b []stamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].modTime.Equal(b[i].modTime) || a[i].size != b[i].size {
+			return false
+		}
+	}
+	return true
+}
+
+// NotAfter returns the expiry of the active certificate.
+func (r *Reloader) NotAfter() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert.Leaf.NotAfter
+}
+
+func (r *Reloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.cert == nil {
+		return nil, errors.New("mtls: no certificate loaded")
+	}
+	return r.cert, nil
+}
+
+// ServerConfig returns a TLS configuration using the active material at
+// each handshake. Each handshake uses a copy of it, so later changes to it,
+// such as the NextProtos an http.Server adds, are kept.
+func (r *Reloader) ServerConfig() *tls.Config {
+	base := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.getCertificate,
+		NextProtos:     []string{"h2", "http/1.1"},
+	}
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cfg := base.Clone()
+		cfg.GetConfigForClient = nil
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		if r.clientCA != nil {
+			cfg.ClientCAs = r.clientCA
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		return cfg, nil
+	}
+	return base
+}
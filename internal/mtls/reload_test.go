@@ -0,0 +1,160 @@
+package mtls
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+)
+
+func pemBytes(t *testing.T, c *issued) (cert, key []byte) {
+	t.Helper()
+	der, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func writeFile(t *testing.T, name string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(name, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReloadKeepsActiveCertificate(t *testing.T) {
+	ca := issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "ca"}, IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil)
+	caPEM, _ := pemBytes(t, ca)
+	server := func(cn string, notBefore, notAfter time.Time) *issued {
+		return issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: cn}, NotBefore: notBefore, NotAfter: notAfter}, ca)
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		replace func(certFile, keyFile, caFile string)
+		want    string // common name served afterwards
+		wantErr bool
+	}{
+		{
+			name: "valid replacement",
+			replace: func(certFile, keyFile, caFile string) {
+				cert, key := pemBytes(t, server("next", time.Time{}, time.Time{}))
+				writeFile(t, certFile, cert)
+				writeFile(t, keyFile, key)
+			},
+			want: "next",
+		},
+		{
+			name: "key of another certificate",
+			replace: func(certFile, keyFile, caFile string) {
+				cert, _ := pemBytes(t, server("next", time.Time{}, time.Time{}))
+				writeFile(t, certFile, cert)
+			},
+			want:    "active",
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			replace: func(certFile, keyFile, caFile string) {
+				cert, key := pemBytes(t, server("next", now.Add(-48*time.Hour), now.Add(-24*time.Hour)))
+				writeFile(t, certFile, cert)
+				writeFile(t, keyFile, key)
+			},
+			want:    "active",
+			wantErr: true,
+		},
+		{
+			name: "not valid yet",
+			replace: func(certFile, keyFile, caFile string) {
+				cert, key := pemBytes(t, server("next", now.Add(time.Hour), now.Add(48*time.Hour)))
+				writeFile(t, certFile, cert)
+				writeFile(t, keyFile, key)
+			},
+			want:    "active",
+			wantErr: true,
+		},
+		{
+			name: "half written certificate",
+			replace: func(certFile, keyFile, caFile string) {
+				writeFile(t, certFile, []byte("-----BEGIN CERTIFICATE-----\nMIIB"))
+			},
+			want:    "active",
+			wantErr: true,
+		},
+		{
+			name:    "empty CA bundle",
+			replace: func(certFile, keyFile, caFile string) { writeFile(t, caFile, nil) },
+			want:    "active",
+			wantErr: true,
+		},
+		{
+			name:    "file gone",
+			replace: func(certFile, keyFile, caFile string) { os.Remove(keyFile) },
+			want:    "active",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			certFile, keyFile, caFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.pem"), filepath.Join(dir, "ca.crt")
+			cert, key := pemBytes(t, server("active", time.Time{}, time.Time{}))
+			writeFile(t, certFile, cert)
+			writeFile(t, keyFile, key)
+			writeFile(t, caFile, caPEM)
+			var logs bytes.Buffer
+			r, err := NewReloader(certFile, keyFile, caFile, logging.New(&logs, logging.Info))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tt.replace(certFile, keyFile, caFile)
+			logs.Reset()
+			r.check()
+			if err := r.Reload(); (err != nil) != tt.wantErr {
+				t.Errorf("Reload = %v, want an error %v", err, tt.wantErr)
+			}
+			active, err := r.getCertificate(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := active.Leaf.Subject.CommonName; got != tt.want {
+				t.Errorf("serving %q, want %q", got, tt.want)
+			}
+			if tt.wantErr && !strings.Contains(logs.String(), "keeping the active certificate") {
+				t.Errorf("the failed reload was not logged: %s", logs.String())
+			}
+			cfg, err := r.ServerConfig().GetConfigForClient(nil)
+			if err != nil || cfg.ClientCAs == nil {
+				t.Errorf("client CA bundle lost: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewReloaderServesExpiredCertificate(t *testing.T) {
+	// at start up there is nothing to keep, an expired certificate is
+	// served with a warning rather than not at all
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.pem")
+	cert, key := pemBytes(t, issue(t, &x509.Certificate{Subject: pkix.Name{CommonName: "old"}, NotBefore: time.Now().Add(-48 * time.Hour), NotAfter: time.Now().Add(-time.Hour)}, nil))
+	writeFile(t, certFile, cert)
+	writeFile(t, keyFile, key)
+	var logs bytes.Buffer
+	if _, err := NewReloader(certFile, keyFile, "", logging.New(&logs, logging.Info)); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(logs.String(), `"level":"warn"`) {
+		t.Errorf("no warning logged: %s", logs.String())
+	}
+}
@@ -0,0 +1,226 @@
+// Package room fans events out to everyone subscribed to a named room, the
+// broadcast side of a bidirectional stream.
+//
+// Each subscriber has a bounded buffer. When a subscriber falls behind, the
+// Policy of the hub either drops the events it has no room for, counting
+// them, or disconnects it with ErrSlowConsumer; a slow subscriber never
+// blocks the others. Rooms remember their last messages and replay them to
+// new subscribers. A room lives while it has subscribers, its history goes
+// with it.
+package room
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrSlowConsumer ends subscriptions that fell behind under the Disconnect
+// policy.
+var ErrSlowConsumer = errors.New("subscriber too slow, disconnected")
+
+// Policy says what happens to a subscriber whose buffer is full.
+type Policy int
+
+const (
+	// Drop skips the events that do not fit, counting them.
+	Drop Policy = iota
+	// Disconnect ends the subscription with ErrSlowConsumer.
+	Disconnect
+)
+
+// ParsePolicy parses "drop" or "disconnect".
+func ParsePolicy(s string) (Policy, error) {
+	switch s {
+	case "drop":
+		return Drop, nil
+	case "disconnect":
+		return Disconnect, nil
+	}
+	return 0, fmt.Errorf("room: unknown slow consumer policy %q, want drop or disconnect", s)
+}
+
+func (p Policy) String() string {
+	if p == Disconnect {
+		return "disconnect"
+	}
+	return "drop"
+}
+
+// Kind is the kind of an event.
+type Kind int
+
+const (
+	// Message is a message from a subscriber, kept in the history.
+	Message Kind = iota
+	// Join announces a subscriber.
+	Join
+	// Leave announces a subscriber has gone.
+	Leave
+)
+
+// Event is an event published to a room.
+type Event struct {
+	Room string
+	// Seq numbers the events of a room from 1.
+	Seq     uint64
+	Kind    Kind
+	Payload interface{}
+	// Replay is set on the history sent to new subscribers.
+	Replay bool
+}
+
+// Config sizes the rooms of a hub.
+type Config struct {
+	// Buffer is the number of events a subscriber may fall behind.
+	Buffer int
+	// History is the number of messages replayed to new subscribers.
+	History int
+	Policy  Policy
+}
+
+// Hub holds the rooms.
+type Hub struct {
+	cfg   Config
+	mu    sync.Mutex
+	rooms map[string]*room
+}
+
+// NewHub returns a hub without rooms.
+func NewHub(cfg Config) *Hub {
+	if cfg.Buffer < 1 {
+		cfg.Buffer = 1
+	}
+	return &Hub{cfg: cfg, rooms: map[string]*room{}}
+}
+
+type room struct {
+	name    string
+	mu      sync.Mutex
+	seq     uint64
+	subs    map[*Subscription]bool
+	history []Event
+}
+
+// Subscription is the membership of one subscriber.
+type Subscription struct {
+	hub     *Hub
+	room    *room
+	events  chan Event
+	dropped uint64
+	// guarded by room.mu
+	member bool
+	left   bool
+	err    error
+}
+
+// Join subscribes to the named room, creating it when needed. The history
+// of the room is queued first, marked as replayed.
+func (h *Hub) Join(name string) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	r, ok := h.rooms[name]
+	if !ok {
+		r = &room{name: name, subs: map[*Subscription]bool{}}
+		h.rooms[name] = r
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := &Subscription{hub: h, room: r, events: make(chan Event, h.cfg.Buffer+len(r.history)), member: true}
+	for _, e := range r.history {
+		e.Replay = true
+		s.events <- e
+	}
+	r.subs[s] = true
+	return s
+}
+
+// Events delivers the events of the room. It is closed when the
+// subscription ends, Err then tells why.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Err returns ErrSlowConsumer once the subscriber was disconnected.
+func (s *Subscription) Err() error {
+	s.room.mu.Lock()
+	defer s.room.mu.Unlock()
+	return s.err
+}
+
+// Dropped returns the number of events dropped because the subscriber was
+// behind.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Publish sends an event to every subscriber of the room, this one
+// included. It fails once the subscription has ended.
+func (s *Subscription) Publish(kind Kind, payload interface{}) (Event, error) {
+	r := s.room
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !s.member {
+		if s.err != nil {
+			return Event{}, s.err
+		}
+		return Event{}, errors.New("room: subscription ended")
+	}
+	return s.hub.publish(r, kind, payload), nil
+}
+
+// publish needs r.mu.
+func (h *Hub) publish(r *room, kind Kind, payload interface{}) Event {
+	r.seq++
+	e := Event{Room: r.name, Seq: r.seq, Kind: kind, Payload: payload}
+	if kind == Message && h.cfg.History > 0 {
+		if len(r.history) == h.cfg.History {
+			r.history = append(r.history[:0], r.history[1:]...)
+		}
+		r.history = append(r.history, e)
+	}
+	for sub := range r.subs {
+		select {
+		case sub.events <- e:
+			continue
+		default:
+		}
+		if h.cfg.Policy == Disconnect {
+			sub.err = ErrSlowConsumer
+			sub.end()
+			continue
+		}
+		atomic.AddUint64(&sub.dropped, 1)
+	}
+	return e
+}
+
+// end needs room.mu.
+func (s *Subscription) end() {
+	if s.member {
+		s.member = false
+		delete(s.room.subs, s)
+		close(s.events)
+	}
+}
+
+// Leave ends the subscription. A non-nil farewell is published to the
+// remaining subscribers as a Leave event, also when the subscriber was
+// disconnected. Leave may be called more than once.
+func (s *Subscription) Leave(farewell interface{}) {
+	h, r := s.hub, s.room
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	announce := farewell != nil && !s.left
+	s.left = true
+	s.end()
+	if announce && len(r.subs) > 0 {
+		h.publish(r, Leave, farewell)
+	}
+	if len(r.subs) == 0 && h.rooms[r.name] == r {
+		delete(h.rooms, r.name)
+	}
+}
@@ -0,0 +1,143 @@
+package room
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// drain returns the events queued on s without blocking, and whether the
+// channel was closed.
+func drain(s *Subscription) (events []Event, closed bool) {
+	for {
+		select {
+		case e, ok := <-s.Events():
+			if !ok {
+				return events, true
+			}
+			events = append(events, e)
+		default:
+			return events, false
+		}
+	}
+}
+
+func describe(events []Event) string {
+	var res string
+	for _, e := range events {
+		res += fmt.Sprintf("%d:%d:%v:%v ", e.Seq, e.Kind, e.Payload, e.Replay)
+	}
+	return res
+}
+
+func TestJoinLeave(t *testing.T) {
+	h := NewHub(Config{Buffer: 10, History: 2})
+	ada := h.Join("lobby")
+	ada.Publish(Join, "ada")
+	ada.Publish(Message, "hi")
+	bob := h.Join("lobby")
+	bob.Publish(Join, "bob")
+	ada.Publish(Message, "hello bob")
+	bob.Leave("bye")
+	bob.Leave("bye again")
+
+	tests := []struct {
+		name string
+		sub  *Subscription
+		want string
+		end  bool
+	}{
+		{"ada", ada, "1:1:ada:false 2:0:hi:false 3:1:bob:false 4:0:hello bob:false 5:2:bye:false ", false},
+		// replayed history first, then what happened while subscribed
+		{"bob", bob, "2:0:hi:true 3:1:bob:false 4:0:hello bob:false ", true},
+	}
+	for _, tt := range tests {
+		events, closed := drain(tt.sub)
+		if got := describe(events); got != tt.want || closed != tt.end {
+			t.Errorf("%s got %q closed %v, want %q closed %v", tt.name, got, closed, tt.want, tt.end)
+		}
+	}
+
+	if _, err := bob.Publish(Message, "still there?"); err == nil {
+		t.Error("Publish after Leave succeeded")
+	}
+	ada.Leave("bye")
+	if len(h.rooms) != 0 {
+		t.Errorf("rooms = %v, want the empty room gone", h.rooms)
+	}
+	// the history went with the room
+	carol := h.Join("lobby")
+	if events, _ := drain(carol); len(events) != 0 {
+		t.Errorf("new room replayed %q", describe(events))
+	}
+}
+
+func TestSlowConsumer(t *testing.T) {
+	tests := []struct {
+		policy     Policy
+		want       string
+		closed     bool
+		dropped    uint64
+		err        error
+		publishErr error
+		fastAfter  string
+	}{
+		{Drop, "1:0:0:false 2:0:1:false ", false, 3, nil, nil, "6:0:late:false 7:2:bye:false "},
+		{Disconnect, "1:0:0:false 2:0:1:false ", true, 0, ErrSlowConsumer, ErrSlowConsumer, "6:2:bye:false "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			h := NewHub(Config{Buffer: 2, Policy: tt.policy})
+			fast := h.Join("lobby")
+			slow := h.Join("lobby")
+			for i := 0; i < 5; i++ {
+				if _, err := fast.Publish(Message, i); err != nil {
+					t.Fatal(err)
+				}
+				drain(fast)
+			}
+
+			events, closed := drain(slow)
+			if got := describe(events); got != tt.want || closed != tt.closed {
+				t.Errorf("slow got %q closed %v, want %q closed %v", got, closed, tt.want, tt.closed)
+			}
+			if slow.Dropped() != tt.dropped || !errors.Is(slow.Err(), tt.err) {
+				t.Errorf("Dropped = %d, Err = %v, want %d, %v", slow.Dropped(), slow.Err(), tt.dropped, tt.err)
+			}
+			if _, err := slow.Publish(Message, "late"); !errors.Is(err, tt.publishErr) {
+				t.Errorf("Publish = %v, want %v", err, tt.publishErr)
+			}
+			// the fast subscriber never noticed
+			if fast.Dropped() != 0 || fast.Err() != nil {
+				t.Errorf("fast Dropped = %d, Err = %v", fast.Dropped(), fast.Err())
+			}
+			// a disconnected subscriber still says goodbye
+			slow.Leave("bye")
+			if events, _ := drain(fast); describe(events) != tt.fastAfter {
+				t.Errorf("fast got %q, want %q", describe(events), tt.fastAfter)
+			}
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Policy
+		wantErr bool
+	}{
+		{"drop", Drop, false},
+		{"disconnect", Disconnect, false},
+		{"Drop", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePolicy(tt.in)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParsePolicy(%q) = %v, %v", tt.in, got, err)
+		}
+		if err == nil && got.String() != tt.in {
+			t.Errorf("%v.String() = %q, want %q", got, got.String(), tt.in)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package rpcerr
+
+import (
+	"context"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// UnaryServerInterceptor converts the errors returned by handlers and the
+// interceptors after it, logging their causes. It is fourth in the chain,
+// after metrics and before mtls.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		res, err := handler(ctx, req)
+		return res, report(ctx, info.FullMethod, err)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return report(ss.Context(), info.FullMethod, handler(srv, ss))
+	}
+}
+
+func report(ctx context.Context, method string, err error) error {
+	err = Convert(err)
+	e, ok := err.(*Error)
+	if !ok || e.Cause == nil {
+		return err
+	}
+	log := logging.FromContext(ctx)
+	switch e.Code {
+	case codes.Internal, codes.Unknown:
+		log.Error("internal error", "method", method, "reason", e.Reason, "error", e.Cause)
+	case codes.Canceled, codes.DeadlineExceeded:
+		// the client gave up, nothing to fix here
+		log.Debug("call ended early", "method", method, "reason", e.Reason, "error", e.Cause)
+	case codes.Unavailable:
+		log.Warn("call failed", "method", method, "reason", e.Reason, "error", e.Cause)
+	default:
+		log.Info("call failed", "method", method, "reason", e.Reason, "error", e.Cause)
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package rpcerr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var errUnreachable = errors.New("store unreachable")
+
+func init() {
+	Register(errUnreachable, Mapping{Code: codes.Unavailable, Reason: "STORE_UNAVAILABLE"})
+}
+
+func TestReportLevels(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string // level of the log event, empty for none
+	}{
+		{"no error", nil, ""},
+		{"invalid argument", InvalidArgument("bad"), ""},
+		{"bug", errors.New("nil map"), "error"},
+		{"canceled", fmt.Errorf("reading: %w", context.Canceled), "debug"},
+		{"deadline", context.DeadlineExceeded, "debug"},
+		{"wrapped domain error", fmt.Errorf("ping: %w", errUnreachable), "warn"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			ctx := logging.NewContext(context.Background(), logging.New(&buf, logging.Debug))
+			_, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/blog.BlogService/ReadBlog"}, func(context.Context, interface{}) (interface{}, error) {
+				return nil, tt.err
+			})
+			if (err == nil) != (tt.err == nil) {
+				t.Fatalf("error = %v", err)
+			}
+			var event struct {
+				Level string `json:"level"`
+			}
+			if buf.Len() > 0 {
+				if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if event.Level != tt.want {
+				t.Errorf("logged at %q, want %q: %s", event.Level, tt.want, buf.String())
+			}
+		})
+	}
+}
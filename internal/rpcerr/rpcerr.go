@@ -0,0 +1,225 @@
+// Package rpcerr turns domain errors into gRPC statuses carrying
+// google.rpc error details, so clients can branch on a stable reason
+// instead of parsing messages.
+//
+// Every status gets an ErrorInfo holding a reason such as "BLOG_NOT_FOUND".
+// Depending on the error it also gets a ResourceInfo naming the resource
+// involved, a BadRequest listing the invalid fields, or a RetryInfo when
+// retrying may succeed. Causes never reach clients: the interceptors log
+// them server side, and errors nobody mapped become a generic INTERNAL
+// status.
+//
+// The servers chain their interceptors in this order, each wrapping those
+// after it:
+//
+//	tracing, logging, metrics, rpcerr, mtls, deadline, validation
+//
+// so the span covers the whole call, logs and metrics see the codes sent,
+// and rpcerr converts the errors of mtls, deadline and validation.
+package rpcerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain is the ErrorInfo domain of the statuses built by this package.
+const Domain = "gogrpc.dipjyotimetia.github.com"
+
+// Reasons shared by every service. Services register their own for their
+// domain errors.
+const (
+	ReasonInternal         = "INTERNAL"
+	ReasonInvalidArgument  = "INVALID_ARGUMENT"
+	ReasonCanceled         = "CANCELED"
+	ReasonDeadlineExceeded = "DEADLINE_EXCEEDED"
+)
+
+// Error is an error as clients see it. It implements GRPCStatus, so
+// handlers can return it as is.
+type Error struct {
+	Code       codes.Code
+	Reason     string
+	Message    string
+	Metadata   map[string]string
+	Resource   *errdetails.ResourceInfo
+	Violations []*errdetails.BadRequest_FieldViolation
+	RetryDelay time.Duration
+	// Cause is logged server side, never sent.
+	Cause error
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error { return e.Cause }
+
+// GRPCStatus returns the status sent to clients.
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Code, e.Message)
+	details := []proto.Message{&errdetails.ErrorInfo{Reason: e.Reason, Domain: Domain, Metadata: e.Metadata}}
+	if e.Resource != nil {
+		details = append(details, e.Resource)
+	}
+	if len(e.Violations) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: e.Violations})
+	}
+	if e.RetryDelay > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryDelay)})
+	}
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// Mapping is the status of a domain error.
+type Mapping struct {
+	Code   codes.Code
+	Reason string
+	// RetryDelay, when set, is sent as a RetryInfo.
+	RetryDelay time.Duration
+}
+
+type registration struct {
+	target error
+	Mapping
+}
+
+var (
+	mu       sync.RWMutex
+	mappings []registration
+)
+
+// Register maps target, and every error wrapping it, to m. Services
+// register their domain errors once, at start up.
+func Register(target error, m Mapping) {
+	mu.Lock()
+	defer mu.Unlock()
+	mappings = append(mappings, registration{target: target, Mapping: m})
+}
+
+func lookup(err error) (registration, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, r := range mappings {
+		if errors.Is(err, r.target) {
+			return r, true
+		}
+	}
+	return registration{}, false
+}
+
+// Option adds context to the Error built by Convert.
+type Option func(*Error)
+
+// WithResource names the resource the error is about, adding a
+// ResourceInfo.
+func WithResource(resourceType, name string) Option {
+	return func(e *Error) {
+		e.Message = fmt.Sprintf("%s: %s", e.Message, name)
+		e.Resource = &errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: name, Description: e.Message}
+	}
+}
+
+// WithField blames the request field at path, adding a BadRequest.
+func WithField(path string) Option {
+	return func(e *Error) {
+		e.Violations = append(e.Violations[:len(e.Violations):len(e.Violations)], &errdetails.BadRequest_FieldViolation{Field: path, Description: e.Message})
+	}
+}
+
+// WithCode overrides the registered code, for errors whose meaning depends
+// on the call, such as a missing author referenced by a new blog.
+func WithCode(code codes.Code) Option {
+	return func(e *Error) { e.Code = code }
+}
+
+// WithMetadata adds a key to the ErrorInfo metadata.
+func WithMetadata(key, value string) Option {
+	return func(e *Error) {
+		md := map[string]string{key: value}
+		for k, v := range e.Metadata {
+			if k != key {
+				md[k] = v
+			}
+		}
+		e.Metadata = md
+	}
+}
+
+// Convert maps err to an *Error. Registered domain errors keep their own
+// message and take their code and reason from the registration; context
+// errors map to CANCELED and DEADLINE_EXCEEDED; anything else becomes an
+// INTERNAL error with err as the hidden cause. Errors that already are
+// statuses are returned unchanged.
+func Convert(err error, opts ...Option) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		c := *e
+		e = &c
+	} else {
+		if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			return err
+		}
+		e = convert(err)
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+func convert(err error) *Error {
+	if r, ok := lookup(err); ok {
+		e := &Error{Code: r.Code, Reason: r.Reason, Message: r.target.Error(), RetryDelay: r.RetryDelay}
+		if err != r.target {
+			e.Cause = err
+		}
+		return e
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return &Error{Code: codes.Canceled, Reason: ReasonCanceled, Message: "call canceled", Cause: err}
+	case errors.Is(err, context.DeadlineExceeded):
+		return &Error{Code: codes.DeadlineExceeded, Reason: ReasonDeadlineExceeded, Message: "deadline exceeded", Cause: err}
+	}
+	return &Error{Code: codes.Internal, Reason: ReasonInternal, Message: "internal error", Cause: err}
+}
+
+// InvalidArgument returns an INVALID_ARGUMENT error listing violations.
+func InvalidArgument(message string, violations ...*errdetails.BadRequest_FieldViolation) *Error {
+	return &Error{Code: codes.InvalidArgument, Reason: ReasonInvalidArgument, Message: message, Violations: violations}
+}
+
+// Reason returns the ErrorInfo reason of a status error, or "" when it has
+// none. Clients use it to branch on errors.
+func Reason(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return ""
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
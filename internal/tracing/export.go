@@ -0,0 +1,266 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// An Exporter sends batches of ended spans, encoded as an OTLP JSON
+// ExportTraceServiceRequest.
+type Exporter interface {
+	Export(ctx context.Context, request []byte) error
+	Close() error
+}
+
+const (
+	batchSize     = 512
+	queueSize     = 4096
+	flushInterval = 5 * time.Second
+	exportTimeout = 10 * time.Second
+)
+
+// batcher exports the ended spans in the background, dropping them when
+// the exporter cannot keep up.
+type batcher struct {
+	service  string
+	exporter Exporter
+	log      Logger
+	queue    chan *Span
+	stop     chan struct{}
+	done     chan struct{}
+	once     sync.Once
+	dropped  int64
+}
+
+func newBatcher(service string, e Exporter, log Logger) *batcher {
+	b := &batcher{
+		service:  service,
+		exporter: e,
+		log:      log,
+		queue:    make(chan *Span, queueSize),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+func (b *batcher) add(s *Span) {
+	select {
+	case b.queue <- s:
+	case <-b.stop:
+	default:
+		atomic.AddInt64(&b.dropped, 1)
+	}
+}
+
+func (b *batcher) run() {
+	defer close(b.done)
+	t := time.NewTicker(flushInterval)
+	defer t.Stop()
+	var batch []*Span
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+		if err := b.exporter.Export(ctx, encode(b.service, batch)); err != nil {
+			b.log.Warn("exporting spans failed", "spans", len(batch), "error", err)
+		}
+		cancel()
+		batch = nil
+	}
+	for {
+		select {
+		case s := <-b.queue:
+			if batch = append(batch, s); len(batch) >= batchSize {
+				export()
+			}
+		case <-t.C:
+			export()
+		case <-b.stop:
+			for {
+				select {
+				case s := <-b.queue:
+					batch = append(batch, s)
+				default:
+					export()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (b *batcher) shutdown(ctx context.Context) error {
+	b.once.Do(func() { close(b.stop) })
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if n := atomic.LoadInt64(&b.dropped); n > 0 {
+		b.log.Warn("dropped the spans the exporter could not keep up with", "spans", n)
+	}
+	return b.exporter.Close()
+}
+
+// encode returns the OTLP JSON ExportTraceServiceRequest of the spans.
+func encode(service string, spans []*Span) []byte {
+	type jsonSpan struct {
+		TraceID      string          `json:"traceId"`
+		SpanID       string          `json:"spanId"`
+		ParentSpanID string          `json:"parentSpanId,omitempty"`
+		Name         string          `json:"name"`
+		Kind         Kind            `json:"kind"`
+		Start        string          `json:"startTimeUnixNano"`
+		End          string          `json:"endTimeUnixNano"`
+		Attributes   []jsonAttribute `json:"attributes,omitempty"`
+		Status       jsonStatus      `json:"status"`
+	}
+	out := make([]jsonSpan, 0, len(spans))
+	for _, s := range spans {
+		s.mu.Lock()
+		js := jsonSpan{
+			TraceID: hex.EncodeToString(s.sc.TraceID[:]),
+			SpanID:  hex.EncodeToString(s.sc.SpanID[:]),
+			Name:    s.name,
+			Kind:    s.kind,
+			Start:   strconv.FormatInt(s.start.UnixNano(), 10),
+			End:     strconv.FormatInt(s.end.UnixNano(), 10),
+		}
+		if s.parent != (SpanID{}) {
+			js.ParentSpanID = hex.EncodeToString(s.parent[:])
+		}
+		for _, a := range s.attrs {
+			js.Attributes = append(js.Attributes, newJSONAttribute(a.key, a.value))
+		}
+		if s.err != "" {
+			js.Status = jsonStatus{Code: 2, Message: s.err}
+		}
+		s.mu.Unlock()
+		out = append(out, js)
+	}
+	req := map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{
+				"attributes": []jsonAttribute{newJSONAttribute("service.name", service)},
+			},
+			"scopeSpans": []interface{}{map[string]interface{}{
+				"scope": map[string]string{"name": "github.com/dipjyotimetia/gogrpc/internal/tracing"},
+				"spans": out,
+			}},
+		}},
+	}
+	data, _ := json.Marshal(req)
+	return data
+}
+
+type jsonStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type jsonAttribute struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+func newJSONAttribute(key string, v interface{}) jsonAttribute {
+	var value map[string]interface{}
+	switch v := v.(type) {
+	case string:
+		value = map[string]interface{}{"stringValue": v}
+	case bool:
+		value = map[string]interface{}{"boolValue": v}
+	case int:
+		value = map[string]interface{}{"intValue": strconv.Itoa(v)}
+	case int64:
+		value = map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+	case uint32:
+		value = map[string]interface{}{"intValue": strconv.FormatUint(uint64(v), 10)}
+	default:
+		value = map[string]interface{}{"stringValue": fmt.Sprint(v)}
+	}
+	return jsonAttribute{Key: key, Value: value}
+}
+
+// OTLPExporter posts spans to an OTLP/HTTP collector, in JSON.
+type OTLPExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewOTLPExporter returns an exporter posting to the /v1/traces path of
+// the collector at endpoint, like http://localhost:4318.
+func NewOTLPExporter(endpoint string) *OTLPExporter {
+	return &OTLPExporter{
+		url:    strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		client: &http.Client{},
+	}
+}
+
+// Export posts one request.
+func (e *OTLPExporter) Export(ctx context.Context, request []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(request))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("collector answered %s", res.Status)
+	}
+	return nil
+}
+
+// Close releases the idle connections.
+func (e *OTLPExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// FileExporter appends spans to a file, one OTLP JSON request per line,
+// the format of the otlpjsonfile receiver of the OpenTelemetry collector.
+type FileExporter struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+// NewFileExporter opens, or creates, the file at path for appending.
+func NewFileExporter(path string) (*FileExporter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileExporter{f: f}, nil
+}
+
+// Export appends one line.
+func (e *FileExporter) Export(_ context.Context, request []byte) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, err := e.f.Write(append(request, '\n'))
+	return err
+}
+
+// Close closes the file.
+func (e *FileExporter) Close() error {
+	return e.f.Close()
+}
@@ -0,0 +1,201 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const traceparentKey = "traceparent"
+
+// startCall starts the span of a call to fullMethod, named service/method
+// as in OTel, with the rpc attributes.
+func startCall(ctx context.Context, t *Tracer, fullMethod string, kind Kind) (context.Context, *Span) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	ctx, span := t.Start(ctx, name, kind)
+	service, method := name, ""
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		service, method = name[:i], name[i+1:]
+	}
+	span.SetAttribute("rpc.system", "grpc")
+	span.SetAttribute("rpc.service", service)
+	span.SetAttribute("rpc.method", method)
+	return ctx, span
+}
+
+func endCall(span *Span, err error) {
+	span.SetAttribute("rpc.grpc.status_code", int(status.Code(err)))
+	span.End(err)
+}
+
+// serverContext continues the trace of the incoming traceparent.
+func serverContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(traceparentKey); len(v) > 0 {
+		if sc, err := ParseTraceparent(v[0]); err == nil {
+			ctx = ContextWithRemoteParent(ctx, sc)
+		}
+	}
+	return ctx
+}
+
+func setPeer(ctx context.Context, span *Span) {
+	if p, ok := peer.FromContext(ctx); ok {
+		span.SetAttribute("net.peer.addr", p.Addr.String())
+	}
+}
+
+// UnaryServerInterceptor traces unary calls, continuing the trace of
+// their traceparent. It is first in the chain, see package rpcerr, so the
+// span covers the other interceptors.
+func UnaryServerInterceptor(t *Tracer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if t == nil {
+			return handler(ctx, req)
+		}
+		ctx, span := startCall(serverContext(ctx), t, info.FullMethod, Server)
+		setPeer(ctx, span)
+		res, err := handler(ctx, req)
+		endCall(span, err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor traces streaming calls and each of their
+// messages.
+func StreamServerInterceptor(t *Tracer) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if t == nil {
+			return handler(srv, ss)
+		}
+		ctx, span := startCall(serverContext(ss.Context()), t, info.FullMethod, Server)
+		setPeer(ctx, span)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx, tracer: t})
+		endCall(span, err)
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	tracer *Tracer
+	sent   int
+	recv   int
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	s.sent++
+	_, span := s.tracer.Start(s.ctx, "send", Internal)
+	span.SetAttribute("message.id", s.sent)
+	err := s.ServerStream.SendMsg(m)
+	span.End(err)
+	return err
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	s.recv++
+	_, span := s.tracer.Start(s.ctx, "receive", Internal)
+	span.SetAttribute("message.id", s.recv)
+	err := s.ServerStream.RecvMsg(m)
+	endMessage(span, err)
+	return err
+}
+
+// endMessage ends the span of a received message, dropping it when the
+// stream ended instead.
+func endMessage(span *Span, err error) {
+	if errors.Is(err, io.EOF) {
+		span.drop()
+		err = nil
+	}
+	span.End(err)
+}
+
+// clientContext starts the span of a call and sends its traceparent.
+func clientContext(ctx context.Context, t *Tracer, method string) (context.Context, *Span) {
+	ctx, span := startCall(ctx, t, method, Client)
+	ctx = metadata.AppendToOutgoingContext(ctx, traceparentKey, span.Context().Traceparent())
+	return ctx, span
+}
+
+// UnaryClientInterceptor traces unary calls and sends their traceparent.
+func UnaryClientInterceptor(t *Tracer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if t == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		ctx, span := clientContext(ctx, t, method)
+		span.SetAttribute("net.peer.name", cc.Target())
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endCall(span, err)
+		return err
+	}
+}
+
+// StreamClientInterceptor traces streaming calls and each of their
+// messages. A call ends when its last message or its error is received.
+func StreamClientInterceptor(t *Tracer) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if t == nil {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		ctx, span := clientContext(ctx, t, method)
+		span.SetAttribute("net.peer.name", cc.Target())
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			endCall(span, err)
+			return nil, err
+		}
+		return &clientStream{ClientStream: cs, ctx: ctx, tracer: t, span: span, serverStreams: desc.ServerStreams}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	ctx           context.Context
+	tracer        *Tracer
+	span          *Span
+	serverStreams bool
+	sent          int
+	recv          int
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	s.sent++
+	_, span := s.tracer.Start(s.ctx, "send", Internal)
+	span.SetAttribute("message.id", s.sent)
+	err := s.ClientStream.SendMsg(m)
+	span.End(err)
+	return err
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	s.recv++
+	_, span := s.tracer.Start(s.ctx, "receive", Internal)
+	span.SetAttribute("message.id", s.recv)
+	err := s.ClientStream.RecvMsg(m)
+	endMessage(span, err)
+	switch {
+	case err == nil:
+		// without a response stream, the single response ends the call
+		if !s.serverStreams {
+			endCall(s.span, nil)
+		}
+	case errors.Is(err, io.EOF):
+		endCall(s.span, nil)
+	default:
+		endCall(s.span, err)
+	}
+	return err
+}
@@ -0,0 +1,238 @@
+// Package tracing records spans of the calls going through clients,
+// servers and the blog store, and exports them to an OTLP collector or to
+// a local file.
+//
+// Traces cross processes in the W3C traceparent header, carried as gRPC
+// metadata by the interceptors of this package; the gateway and gRPC-Web
+// front ends forward it from HTTP requests. A nil *Tracer, like a nil
+// *Span, records nothing, so code can be traced unconditionally.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TraceID identifies a trace.
+type TraceID [16]byte
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+// SpanContext is the part of a span propagated to other processes.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// IsValid tells whether the trace and span ids are set.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// Traceparent formats sc as a W3C traceparent header.
+func (sc SpanContext) Traceparent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return "00-" + hex.EncodeToString(sc.TraceID[:]) + "-" + hex.EncodeToString(sc.SpanID[:]) + "-" + flags
+}
+
+// ParseTraceparent parses a W3C traceparent header.
+func ParseTraceparent(h string) (SpanContext, error) {
+	parts := strings.Split(strings.TrimSpace(h), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return SpanContext{}, fmt.Errorf("tracing: malformed traceparent %q", h)
+	}
+	var sc SpanContext
+	var flags [1]byte
+	if err := decodeHex(sc.TraceID[:], parts[1]); err != nil {
+		return SpanContext{}, fmt.Errorf("tracing: malformed trace id in %q", h)
+	}
+	if err := decodeHex(sc.SpanID[:], parts[2]); err != nil {
+		return SpanContext{}, fmt.Errorf("tracing: malformed span id in %q", h)
+	}
+	if err := decodeHex(flags[:], parts[3]); err != nil {
+		return SpanContext{}, fmt.Errorf("tracing: malformed flags in %q", h)
+	}
+	if !sc.IsValid() {
+		return SpanContext{}, fmt.Errorf("tracing: zero id in traceparent %q", h)
+	}
+	sc.Sampled = flags[0]&1 == 1
+	return sc, nil
+}
+
+func decodeHex(dst []byte, s string) error {
+	if len(s) != 2*len(dst) || strings.ToLower(s) != s {
+		return fmt.Errorf("bad length")
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
+
+// Kind tells the role of a span in a call.
+type Kind int
+
+// Kinds of span, numbered as in OTLP.
+const (
+	Internal Kind = 1
+	Server   Kind = 2
+	Client   Kind = 3
+)
+
+// Span is one timed operation of a trace.
+type Span struct {
+	tracer *Tracer
+	sc     SpanContext
+	parent SpanID
+	name   string
+	kind   Kind
+	start  time.Time
+
+	mu      sync.Mutex
+	end     time.Time
+	attrs   []attribute
+	err     string
+	ended   bool
+	dropped bool
+}
+
+type attribute struct {
+	key   string
+	value interface{}
+}
+
+// Context returns the propagated part of s.
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+// SetAttribute sets an attribute, a string, an integer or a bool.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil || !s.sc.Sampled {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, a := range s.attrs {
+		if a.key == key {
+			s.attrs[i].value = value
+			return
+		}
+	}
+	s.attrs = append(s.attrs, attribute{key, value})
+}
+
+// End ends s, failed when err is not nil, and queues it for export.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.end = time.Now()
+	if err != nil {
+		s.err = err.Error()
+	}
+	export := s.sc.Sampled && !s.dropped
+	s.mu.Unlock()
+	if export {
+		s.tracer.batcher.add(s)
+	}
+}
+
+// drop keeps s from being exported, for spans found useless once done.
+func (s *Span) drop() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.dropped = true
+	s.mu.Unlock()
+}
+
+type spanKey struct{}
+
+type remoteKey struct{}
+
+// SpanFromContext returns the span of ctx, nil if there is none.
+func SpanFromContext(ctx context.Context) *Span {
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	return s
+}
+
+// ContextWithRemoteParent returns a context whose next span continues the
+// trace of sc, received from another process.
+func ContextWithRemoteParent(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, remoteKey{}, sc)
+}
+
+// Tracer starts the spans of a service.
+type Tracer struct {
+	service string
+	ratio   float64
+	batcher *batcher
+}
+
+// Logger receives the export failures, a *logging.Logger in the servers.
+type Logger interface {
+	Warn(msg string, kv ...interface{})
+}
+
+// New returns a Tracer of the service exporting to e. ratio is the share
+// of the new traces recorded; traces continued from another process
+// follow its decision.
+func New(service string, e Exporter, ratio float64, log Logger) *Tracer {
+	return &Tracer{service: service, ratio: ratio, batcher: newBatcher(service, e, log)}
+}
+
+// Start starts a span, a child of the span of ctx or of its remote
+// parent, and returns a context carrying it.
+func (t *Tracer) Start(ctx context.Context, name string, kind Kind) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+	s := &Span{tracer: t, name: name, kind: kind, start: time.Now()}
+	if parent := SpanFromContext(ctx); parent != nil {
+		s.sc.TraceID, s.parent, s.sc.Sampled = parent.sc.TraceID, parent.sc.SpanID, parent.sc.Sampled
+	} else if remote, ok := ctx.Value(remoteKey{}).(SpanContext); ok && remote.IsValid() {
+		s.sc.TraceID, s.parent, s.sc.Sampled = remote.TraceID, remote.SpanID, remote.Sampled
+	} else {
+		rand.Read(s.sc.TraceID[:])
+		s.sc.Sampled = t.sample(s.sc.TraceID)
+	}
+	rand.Read(s.sc.SpanID[:])
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// sample decides from the trace id, so every process deciding for the same
+// trace at the same ratio agrees.
+func (t *Tracer) sample(id TraceID) bool {
+	if t.ratio >= 1 {
+		return true
+	}
+	return float64(binary.BigEndian.Uint64(id[8:])>>11)/(1<<53) < t.ratio
+}
+
+// Shutdown exports the spans left and closes the exporter.
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	return t.batcher.shutdown(ctx)
+}
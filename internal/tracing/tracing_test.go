@@ -0,0 +1,51 @@
+package tracing
+
+import "testing"
+
+func TestTraceparent(t *testing.T) {
+	const (
+		trace = "4bf92f3577b34da6a3ce929d0e0e4736"
+		span  = "00f067aa0ba902b7"
+	)
+	tests := []struct {
+		name    string
+		in      string
+		sampled bool
+		out     string // formatted back, empty when parsing fails
+	}{
+		{"sampled", "00-" + trace + "-" + span + "-01", true, "00-" + trace + "-" + span + "-01"},
+		{"not sampled", "00-" + trace + "-" + span + "-00", false, "00-" + trace + "-" + span + "-00"},
+		{"other flags ignored", "00-" + trace + "-" + span + "-03", true, "00-" + trace + "-" + span + "-01"},
+		{"surrounding spaces", " 00-" + trace + "-" + span + "-01 ", true, "00-" + trace + "-" + span + "-01"},
+		{"future version with more fields", "01-" + trace + "-" + span + "-01-extra", true, "00-" + trace + "-" + span + "-01"},
+		{"version 00 with more fields", "00-" + trace + "-" + span + "-01-extra", false, ""},
+		{"forbidden version", "ff-" + trace + "-" + span + "-01", false, ""},
+		{"missing field", "00-" + trace + "-" + span, false, ""},
+		{"short trace id", "00-" + trace[2:] + "-" + span + "-01", false, ""},
+		{"uppercase trace id", "00-4BF92F3577B34DA6A3CE929D0E0E4736-" + span + "-01", false, ""},
+		{"not hex", "00-" + trace + "-00f067aa0ba902bz-01", false, ""},
+		{"zero trace id", "00-00000000000000000000000000000000-" + span + "-01", false, ""},
+		{"zero span id", "00-" + trace + "-0000000000000000-01", false, ""},
+		{"empty", "", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, err := ParseTraceparent(tt.in)
+			if tt.out == "" {
+				if err == nil {
+					t.Fatalf("ParseTraceparent(%q) = %+v, want an error", tt.in, sc)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sc.Sampled != tt.sampled {
+				t.Errorf("Sampled = %v, want %v", sc.Sampled, tt.sampled)
+			}
+			if got := sc.Traceparent(); got != tt.out {
+				t.Errorf("Traceparent() = %q, want %q", got, tt.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Package validation runs the protoc-gen-validate rules declared in the
+// protos on every incoming request, so handlers only see valid input.
+package validation
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// reasonEmbedded is the reason protoc-gen-validate gives when a nested
+// message is invalid; the cause then holds the nested violations.
+const reasonEmbedded = "embedded message failed validation"
+
+// fieldError is implemented by every generated <Message>ValidationError.
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// UnaryServerInterceptor rejects unary requests that fail validation. It
+// is last in the chain, after deadline.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := Check(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor validates every message received on a stream.
+// An invalid message is reported to the handler as the error of RecvMsg.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return Check(m)
+}
+
+// Check validates msg and returns an INVALID_ARGUMENT rpcerr.Error carrying
+// a google.rpc.BadRequest with one violation per broken rule, or nil.
+// Messages without generated validators always pass.
+func Check(msg interface{}) error {
+	v, ok := msg.(interface{ ValidateAll() error })
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	if m, ok := msg.(proto.Message); ok {
+		violations = fieldViolations("", m.ProtoReflect().Descriptor(), err)
+	}
+	if len(violations) == 0 {
+		return rpcerr.InvalidArgument(err.Error())
+	}
+
+	msgs := make([]string, len(violations))
+	for i, fv := range violations {
+		msgs[i] = fv.GetField() + ": " + fv.GetDescription()
+	}
+	return rpcerr.InvalidArgument("invalid request: "+strings.Join(msgs, "; "), violations...)
+}
+
+// fieldViolations flattens the errors returned by ValidateAll into
+// violations whose fields are dotted paths of proto field names, such as
+// "blog.tags[2]".
+func fieldViolations(prefix string, md protoreflect.MessageDescriptor, err error) []*errdetails.BadRequest_FieldViolation {
+	var all interface{ AllErrors() []error }
+	if errors.As(err, &all) {
+		var out []*errdetails.BadRequest_FieldViolation
+		for _, e := range all.AllErrors() {
+			out = append(out, fieldViolations(prefix, md, e)...)
+		}
+		return out
+	}
+
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: strings.TrimSuffix(prefix, "."), Description: err.Error()}}
+	}
+	fd, index := lookupField(md, fe.Field())
+	path := prefix + fe.Field()
+	if fd != nil {
+		path = prefix + string(fd.Name()) + index
+	}
+	if fe.Reason() == reasonEmbedded && fe.Cause() != nil && fd != nil && fd.Message() != nil {
+		return fieldViolations(path+".", fd.Message(), fe.Cause())
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: fe.Reason()}}
+}
+
+// lookupField maps the Go field name used by the generated errors, possibly
+// followed by an index such as "Tags[2]", back to the proto field.
+func lookupField(md protoreflect.MessageDescriptor, goName string) (protoreflect.FieldDescriptor, string) {
+	index := ""
+	if i := strings.IndexByte(goName, '['); i >= 0 {
+		goName, index = goName[:i], goName[i:]
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if strings.EqualFold(strings.ReplaceAll(string(fd.Name()), "_", ""), goName) {
+			return fd, index
+		}
+	}
+	return nil, index
+}
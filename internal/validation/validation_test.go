@@ -0,0 +1,97 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	"github.com/dipjyotimetia/gogrpc/greet/greetpb"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestCheck(t *testing.T) {
+	validBlog := func() *blogpb.Blog {
+		return &blogpb.Blog{AuthorId: "ada", Title: "Notes", Tags: []string{"history"}}
+	}
+	tests := []struct {
+		name string
+		msg  interface{}
+		want []string // field paths of the violations, in order
+	}{
+		{
+			name: "valid",
+			msg:  &blogpb.CreateBlogRequest{Blog: validBlog()},
+		},
+		{
+			name: "no validator",
+			msg:  "not a message",
+		},
+		{
+			name: "required message",
+			msg:  &blogpb.CreateBlogRequest{},
+			want: []string{"blog"},
+		},
+		{
+			name: "nested fields use proto names",
+			msg:  &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{Id: "x", Title: "Notes"}},
+			want: []string{"blog.id", "blog.author_id"},
+		},
+		{
+			name: "repeated item",
+			msg: &blogpb.UpdateBlogRequest{Blog: func() *blogpb.Blog {
+				b := validBlog()
+				b.Tags = []string{"go", "", "grpc"}
+				return b
+			}()},
+			want: []string{"blog.tags[1]"},
+		},
+		{
+			name: "top level fields",
+			msg:  &blogpb.GetRelatedBlogsRequest{BlogId: "nope", Limit: -1},
+			want: []string{"blog_id", "limit"},
+		},
+		{
+			name: "greet",
+			msg:  &greetpb.GreetRequest{Greeting: &greetpb.Greeting{}, Locale: "not a locale", Style: 7},
+			want: []string{"greeting.first_name", "locale", "style"},
+		},
+		{
+			name: "interval in range",
+			msg:  &greetpb.GreetManyTimesRequest{Greeting: &greetpb.Greeting{FirstName: "Ada"}, Interval: durationpb.New(time.Minute)},
+		},
+		{
+			name: "interval out of range",
+			msg:  &greetpb.GreetManyTimesRequest{Greeting: &greetpb.Greeting{FirstName: "Ada"}, Interval: durationpb.New(-time.Second)},
+			want: []string{"interval"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(tt.msg)
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Check = %v, want nil", err)
+				}
+				return
+			}
+			var e *rpcerr.Error
+			if !errors.As(err, &e) || e.Code != codes.InvalidArgument {
+				t.Fatalf("Check = %v, want an INVALID_ARGUMENT rpcerr.Error", err)
+			}
+			var got []string
+			for _, v := range e.Violations {
+				got = append(got, v.GetField())
+				if v.GetDescription() == "" {
+					t.Errorf("violation of %s has no description", v.GetField())
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+)
+
+// settings of the load test, from the config file, PERF_* variables and
+// flags.
+type settings struct {
+	Target      string           `config:"target" usage:"address of the blog server"`
+	Concurrency int              `config:"concurrency" usage:"workers sending requests at once"`
+	Requests    int              `config:"requests" usage:"requests sent in total"`
+	Connections int              `config:"connections" usage:"connections the workers share"`
+	TLS         config.ClientTLS `config:",inline"`
+}
+
+func defaultSettings() settings {
+	return settings{
+		Target:      "localhost:50053",
+		Concurrency: 50,
+		Requests:    300,
+		Connections: 20,
+		TLS:         config.ClientTLS{CACert: "ssl/ca.crt"},
+	}
+}
+
+func (c *settings) Check(p *config.Problems) {
+	p.Addr("target", c.Target, false)
+	p.Min("concurrency", c.Concurrency, 1)
+	p.Min("requests", c.Requests, 1)
+	p.Min("connections", c.Connections, 1)
+	if c.Connections > c.Concurrency {
+		p.Add("connections", "%d is more than the %d workers", c.Connections, c.Concurrency)
+	}
+	c.TLS.Check(p)
+}
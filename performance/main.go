@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/bojand/ghz/printer"
 	"github.com/bojand/ghz/runner"
 	blogpb "github.com/dipjyotimetia/gogrpc/blog/blogPb"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
 	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"log"
 	"os"
 	"strconv"
@@ -20,24 +25,79 @@ type ResultReport struct {
 	TotalRequest uint64
 }
 
+const authorID = "f6a4c808-c33d-4c15-9722-fc62a3533e70"
+
+// registerAuthor creates the author the generated blogs point at, as
+// CreateBlog rejects unknown authors.
+func registerAuthor(target string, creds grpc.DialOption) error {
+	cc, err := grpc.Dial(target, creds)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = blogpb.NewAuthorServiceClient(cc).CreateAuthor(context.Background(), &blogpb.CreateAuthorRequest{
+		Author: &blogpb.Author{Id: authorID, DisplayName: "Performance test"},
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
+
+// allOf applies opts in order.
+func allOf(opts ...runner.Option) runner.Option {
+	return func(c *runner.RunConfig) error {
+		for _, opt := range opts {
+			if err := opt(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 func main() {
+	cfg := defaultSettings()
+	if _, err := config.Load("perf", &cfg); err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+
+	creds, err := cfg.TLS.DialOption()
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	tlsOption := runner.WithInsecure(true)
+	if cfg.TLS.Enabled {
+		tlsOption = allOf(
+			runner.WithRootCertificate(cfg.TLS.CACert),
+			runner.WithCertificate(cfg.TLS.Cert, cfg.TLS.Key),
+			runner.WithServerNameOverride(cfg.TLS.ServerName),
+		)
+	}
+
+	if err := registerAuthor(cfg.Target, creds); err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
 	report, err := runner.Run(
 		"blog.BlogService.CreateBlog",
-		"localhost:50051",
+		cfg.Target,
 		runner.WithName("Create Blog"),
 		// runner.WithProtoFile("blog/blogPb/blog.proto", []string{}), //TODO: gRPC Reflection api is configured
 		runner.WithData(&blogpb.CreateBlogRequest{
 			Blog: &blogpb.Blog{
 				Id:       strconv.Itoa(int(uuid.New().ID())),
-				AuthorId: "f6a4c808-c33d-4c15-9722-fc62a3533e70",
+				AuthorId: authorID,
 				Title:    "Hello",
 				Content:  "Hello",
 			}}),
-		runner.WithConcurrency(50),
-		runner.WithTotalRequests(300),
-		runner.WithConnections(20),
+		runner.WithConcurrency(uint(cfg.Concurrency)),
+		runner.WithTotalRequests(uint(cfg.Requests)),
+		runner.WithConnections(uint(cfg.Connections)),
 		runner.WithConcurrencyDuration(time.Duration(time.Duration(20).Seconds())),
-		runner.WithInsecure(true),
+		tlsOption,
 	)
 	if err != nil {
 		fmt.Println(err.Error())
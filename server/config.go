@@ -0,0 +1,81 @@
+package main
+
+import (
+	"time"
+
+	blogservice "github.com/dipjyotimetia/gogrpc/blog/blogService"
+	greetservice "github.com/dipjyotimetia/gogrpc/greet/greetService"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+)
+
+// settings of the combined server, from the config file, SERVER_*
+// variables and flags.
+type settings struct {
+	Addr        string `config:"addr" usage:"gRPC and gRPC-Web listen address of all the services"`
+	HTTPAddr    string `config:"http-addr" usage:"HTTP listen address for the /v1 JSON API and the blog feeds, empty to disable"`
+	MetricsAddr string `config:"metrics-addr" usage:"listen address of the Prometheus /metrics endpoint and the health probes, empty to disable"`
+	AdminAddr   string `config:"admin-addr" usage:"listen address of /debug/logging, changing the logging at runtime, empty to disable; keep it on loopback"`
+	WebOrigins  string `config:"grpc-web-origins" usage:"comma separated origins allowed to call over gRPC-Web from other sites, * for any, none when empty"`
+
+	EnableGreet bool `config:"greet" usage:"host the greet service"`
+	EnableCalc  bool `config:"calc" usage:"host the calc service"`
+	EnableBlog  bool `config:"blog" usage:"host the blog services"`
+
+	Greet     greetservice.Settings `config:",inline"`
+	Blog      blogservice.Settings  `config:",inline"`
+	Deadlines config.Deadlines      `config:",inline"`
+	Shutdown  config.Shutdown       `config:",inline"`
+	Health    config.Health         `config:",inline"`
+	Tracing   config.Tracing        `config:",inline"`
+	Logging   config.Logging        `config:",inline"`
+	TLS       config.ServerTLS      `config:",inline"`
+}
+
+func defaultSettings() settings {
+	blog := blogservice.DefaultSettings()
+	blog.FeedBaseURL = "http://localhost:8090"
+	return settings{
+		Addr:        "0.0.0.0:50050",
+		HTTPAddr:    "0.0.0.0:8090",
+		MetricsAddr: "0.0.0.0:9090",
+		AdminAddr:   "127.0.0.1:9190",
+		EnableGreet: true,
+		EnableCalc:  true,
+		EnableBlog:  true,
+		Greet:       greetservice.DefaultSettings(),
+		Blog:        blog,
+		Deadlines: config.Deadlines{
+			Default:        30 * time.Second,
+			MethodMinimums: greetservice.MethodMinDeadlines,
+		},
+		Shutdown: config.DefaultShutdown(),
+		Health:   config.DefaultHealth(),
+		Tracing:  config.DefaultTracing(),
+		Logging:  config.DefaultLogging(),
+		TLS:      config.ServerTLS{ReloadInterval: 30 * time.Second},
+	}
+}
+
+func (c *settings) Check(p *config.Problems) {
+	p.Addr("addr", c.Addr, false)
+	p.Addr("http-addr", c.HTTPAddr, true)
+	p.Addr("metrics-addr", c.MetricsAddr, true)
+	p.Addr("admin-addr", c.AdminAddr, true)
+	p.Distinct(map[string]string{"addr": c.Addr, "http-addr": c.HTTPAddr, "metrics-addr": c.MetricsAddr, "admin-addr": c.AdminAddr})
+	if !c.EnableGreet && !c.EnableCalc && !c.EnableBlog {
+		p.Add("greet", "no service enabled, enable greet, calc or blog")
+	}
+	// settings of disabled services are not used
+	if c.EnableGreet {
+		c.Greet.Check(p)
+	}
+	if c.EnableBlog {
+		c.Blog.Check(p)
+	}
+	c.Deadlines.Check(p)
+	c.Shutdown.Check(p)
+	c.Health.Check(p)
+	c.Tracing.Check(p)
+	c.Logging.Check(p)
+	c.TLS.Check(p, false)
+}
@@ -0,0 +1,206 @@
+// Command server hosts the greet, calc and blog services on one gRPC
+// server and port, for development environments running them all.
+//
+// The services share the interceptors, the TLS settings and the HTTP
+// gateway, and each can be turned off with -greet=false, -calc=false or
+// -blog=false. Every enabled service reports its status through the
+// grpc.health.v1.Health service and is listed by server reflection.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	blogservice "github.com/dipjyotimetia/gogrpc/blog/blogService"
+	calcservice "github.com/dipjyotimetia/gogrpc/calculator/calcService"
+	"github.com/dipjyotimetia/gogrpc/calculator/calcpb"
+	greetservice "github.com/dipjyotimetia/gogrpc/greet/greetService"
+	"github.com/dipjyotimetia/gogrpc/greet/greetpb"
+	"github.com/dipjyotimetia/gogrpc/internal/config"
+	"github.com/dipjyotimetia/gogrpc/internal/deadline"
+	"github.com/dipjyotimetia/gogrpc/internal/gateway"
+	"github.com/dipjyotimetia/gogrpc/internal/grpcweb"
+	"github.com/dipjyotimetia/gogrpc/internal/healthcheck"
+	"github.com/dipjyotimetia/gogrpc/internal/lifecycle"
+	"github.com/dipjyotimetia/gogrpc/internal/logging"
+	"github.com/dipjyotimetia/gogrpc/internal/metrics"
+	"github.com/dipjyotimetia/gogrpc/internal/mtls"
+	"github.com/dipjyotimetia/gogrpc/internal/rpcerr"
+	"github.com/dipjyotimetia/gogrpc/internal/tracing"
+	"github.com/dipjyotimetia/gogrpc/internal/validation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	cfg := defaultSettings()
+	effective, err := config.Load("server", &cfg)
+	if err != nil {
+		logging.Default().Fatal("invalid configuration", "error", err)
+	}
+	logger, payloads := cfg.Logging.Logger()
+	logger.Info("configuration", effective.Fields()...)
+
+	// register adds the enabled services to a gRPC server, the public
+	// ones only for gRPC-Web and the gateway
+	var registers, publicRegisters []func(*grpc.Server)
+	var serving []string
+	if cfg.EnableGreet {
+		// one server for native, gRPC-Web and gateway calls, they share the rooms
+		greet, err := greetservice.New(cfg.Greet)
+		if err != nil {
+			logger.Fatal("failed to load the greetings", "error", err)
+		}
+		logger.Info("greeting", "locales", strings.Join(greet.Locales(), ","))
+		register := func(s *grpc.Server) { greetpb.RegisterGreetServiceServer(s, greet) }
+		registers = append(registers, register)
+		publicRegisters = append(publicRegisters, register)
+		serving = append(serving, greetservice.ServiceName)
+	}
+	if cfg.EnableCalc {
+		calc := calcservice.New()
+		register := func(s *grpc.Server) { calcpb.RegisterSumServiceServer(s, calc) }
+		registers = append(registers, register)
+		publicRegisters = append(publicRegisters, register)
+		serving = append(serving, calcservice.ServiceName)
+	}
+	tracer, err := cfg.Tracing.Tracer("server", logger)
+	if err != nil {
+		logger.Fatal("failed to start tracing", "error", err)
+	}
+	lc := cfg.Shutdown.Lifecycle(logger)
+	lc.AddDependency("tracer", tracer.Shutdown)
+	registry := metrics.NewRegistry()
+	rpcMetrics := metrics.NewServerRPC(registry)
+	var blog *blogservice.Service
+	if cfg.EnableBlog {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		cfg.Blog.Metrics = registry
+		cfg.Blog.Tracer = tracer
+		cfg.Blog.Logger = logger
+		blog, err = blogservice.Open(ctx, cfg.Blog)
+		cancel()
+		if err != nil {
+			logger.Fatal("failed to open the blog service", "error", err)
+		}
+		lc.AddDependency("blog service", blog.Close)
+		registers = append(registers, blog.Register)
+		publicRegisters = append(publicRegisters, blog.RegisterPublic)
+		serving = append(serving, blogservice.ServiceNames...)
+	}
+
+	lis, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		logger.Fatal("failed to listen", "addr", cfg.Addr, "error", err)
+	}
+	deadlines, err := cfg.Deadlines.Policy()
+	if err != nil {
+		logger.Fatal("invalid deadlines", "error", err)
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(tracer), logging.UnaryServerInterceptor(logger, payloads), rpcMetrics.UnaryServerInterceptor(), rpcerr.UnaryServerInterceptor(), mtls.UnaryServerInterceptor(cfg.Blog.RestrictAdmin()), deadline.UnaryServerInterceptor(deadlines), validation.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(tracer), logging.StreamServerInterceptor(logger, payloads), rpcMetrics.StreamServerInterceptor(), rpcerr.StreamServerInterceptor(), mtls.StreamServerInterceptor(cfg.Blog.RestrictAdmin()), deadline.StreamServerInterceptor(deadlines), validation.StreamServerInterceptor()),
+	}
+	serverOpts := opts
+	monitor := cfg.Health.Monitor(logger)
+	// the gRPC-Web mux terminates TLS, native calls and browsers share the port
+	var tlsConfig *tls.Config
+	if cfg.TLS.Enabled() {
+		certs, err := mtls.NewReloader(cfg.TLS.Cert, cfg.TLS.Key, cfg.TLS.ClientCA, logger)
+		if err != nil {
+			logger.Fatal("failed loading certificates", "error", err)
+		}
+		monitor.AddCheck("tls certificate", healthcheck.CertificateExpiry(certs.NotAfter))
+		if cfg.TLS.ReloadInterval > 0 {
+			go certs.Watch(context.Background(), cfg.TLS.ReloadInterval)
+		}
+		tlsConfig = certs.ServerConfig()
+		serverOpts = append(serverOpts, grpc.Creds(grpcweb.TLSCredentials()))
+	}
+
+	s := grpc.NewServer(serverOpts...)
+	for _, register := range registers {
+		register(s)
+	}
+	monitor.AddService(serving...)
+	if blog != nil {
+		monitor.AddCheck("blog store", blog.Ping, blogservice.ServiceNames...)
+	}
+	monitor.Register(s)
+	monitor.Start()
+	lc.SetHealth(monitor.Server())
+	reflection.Register(s)
+	logger.Info("serving", "services", strings.Join(serving, ","), "addr", cfg.Addr)
+
+	registerPublic := func(s *grpc.Server) {
+		for _, register := range publicRegisters {
+			register(s)
+		}
+	}
+	web, err := grpcweb.NewServer(s, lis, tlsConfig, registerPublic, opts, grpcweb.AllowedOrigins(strings.Split(cfg.WebOrigins, ",")...))
+	if err != nil {
+		logger.Fatal("failed to start gRPC-Web", "error", err)
+	}
+	lc.AddServer("server", web)
+	lc.AddDependency("health checks", monitor.Stop)
+	if cfg.MetricsAddr != "" {
+		metricsServer := metrics.NewServer(cfg.MetricsAddr, registry, map[string]http.Handler{
+			"/debug/logging": logging.ReadOnlyHandler(logger, payloads),
+			"/healthz":       monitor,
+			"/readyz":        monitor,
+		})
+		lc.AddServer("metrics server", lifecycle.HTTP(metricsServer))
+		go func() {
+			logger.Info("serving metrics", "addr", cfg.MetricsAddr)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to serve metrics", "error", err)
+			}
+		}()
+	}
+	if cfg.AdminAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/logging", logging.Handler(logger, payloads))
+		adminServer := &http.Server{Addr: cfg.AdminAddr, Handler: mux}
+		lc.AddServer("admin server", lifecycle.HTTP(adminServer))
+		go func() {
+			logger.Info("serving admin endpoints", "addr", cfg.AdminAddr)
+			if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatal("failed to serve admin endpoints", "error", err)
+			}
+		}()
+	}
+	go func() {
+		if err := web.Serve(); err != nil {
+			logger.Fatal("failed to serve", "error", err)
+		}
+	}()
+
+	if cfg.HTTPAddr != "" {
+		gw, stopGateway, err := gateway.InProcess(registerPublic, opts...)
+		if err != nil {
+			logger.Fatal("failed to start gateway", "error", err)
+		}
+		lc.AddDependency("gateway", func(context.Context) error { stopGateway(); return nil })
+		mux := http.NewServeMux()
+		mux.Handle("/v1/", gw)
+		mux.Handle("/healthz", monitor)
+		mux.Handle("/readyz", monitor)
+		if blog != nil {
+			mux.Handle("/feeds/", blog.Feeds())
+		}
+		httpServer := &http.Server{Addr: cfg.HTTPAddr, Handler: mux, TLSConfig: tlsConfig}
+		lc.AddServer("gateway server", lifecycle.HTTP(httpServer))
+		go func() {
+			logger.Info("serving gateway", "addr", cfg.HTTPAddr)
+			if err := gateway.Serve(httpServer); err != nil {
+				logger.Fatal("failed to serve gateway", "error", err)
+			}
+		}()
+	}
+
+	lc.Wait()
+}
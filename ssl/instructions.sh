@@ -1,13 +0,0 @@
-
-
-openssl genrsa -passout pass:1111 -des3 -out ca.key 4096
-openssl req -passin pass:1111 -new -x509 -days 365 -key ca.key -out ca.crt -subj "//CN=localhost"
-
-openssl genrsa -passout pass:1111 -des3 -out server.key 4096
-
-openssl req -passin pass:1111 -new -key server.key -out server.csr -subj "//CN=localhost"
-
-openssl x509 -req -passin pass:1111 -days 365 -in server.csr -CA ca.crt -CAkey ca.key -set_serial 01 -out server.crt
-
-openssl pkcs8 -topk8 -nocrypt -passin pass:1111 -in server.key -out server.pem
-